@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
@@ -8,22 +9,54 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	kvgo "github.com/caelansar/kv-go"
 	"github.com/caelansar/kv-go/client/multiplex"
 	abi "github.com/caelansar/kv-go/pb"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Client struct {
-	logger  *zap.SugaredLogger
-	codec   kvgo.Codec
-	session multiplex.Session
+	logger *zap.SugaredLogger
+	// codec as given to NewClient, a Negotiable one is never modified but
+	// copied into sessionCodec with the options agreed for the session
+	codec        kvgo.Codec
+	sessionCodec atomic.Value // kvgo.Codec
+	session      multiplex.Session
+	// frame options asked for in Negotiate, see NewClient
+	compressions []kvgo.Compression
+	checksum     bool
+
+	mu    sync.RWMutex
+	hello *ServerHello
+
+	interceptors       []Interceptor
+	streamInterceptors []StreamInterceptor
+	invoker            Invoker
+	streamInvoker      StreamInvoker
 }
 
+// StreamResult delivers the responses of a streaming command, either raw
+// through Chan or, for subscriptions, as envelopes through Messages. Only one
+// of them should be read.
 type StreamResult struct {
 	id uint32
 	ch <-chan *abi.CommandResponse
+
+	once     sync.Once
+	messages chan *abi.Message
+
+	mu  sync.Mutex
+	err error
+
+	stream *stream
+	// unsubscribe cancels the subscription on the server, nil for other
+	// streaming commands
+	unsubscribe func(ctx context.Context) error
 }
 
 func (s *StreamResult) Id() uint32 {
@@ -34,75 +67,291 @@ func (s *StreamResult) Chan() <-chan *abi.CommandResponse {
 	return s.ch
 }
 
+// Err reports why the stream ended once its channel is closed: nil when
+// unsubscribed, a *StreamEndError when the server ended it for another reason,
+// ErrHeartbeatMissed when it went silent, or the error that broke it.
+func (s *StreamResult) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *StreamResult) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+// Close unsubscribes, waits for the server to end the stream while discarding
+// the responses still in flight, then closes the stream. Streams of other
+// commands are just closed.
+func (s *StreamResult) Close(ctx context.Context) error {
+	defer s.stream.Close()
+	if s.unsubscribe == nil {
+		return nil
+	}
+	if err := s.unsubscribe(ctx); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	// once Do returned, Messages either runs or never will
+	s.once.Do(func() {})
+	if s.messages != nil {
+		return drain(ctx, s.messages)
+	}
+	return drain(ctx, s.ch)
+}
+
+func drain[T any](ctx context.Context, ch <-chan T) error {
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Messages returns the messages of a subscription. Servers that don't send
+// envelopes get theirs built from the response topic and values.
+func (s *StreamResult) Messages() <-chan *abi.Message {
+	s.once.Do(func() {
+		s.messages = make(chan *abi.Message, cap(s.ch))
+		go func() {
+			defer close(s.messages)
+			for resp := range s.ch {
+				msg := resp.Envelope
+				if msg == nil {
+					msg = &abi.Message{Topic: resp.Topic, Payload: resp.Values}
+				}
+				s.messages <- msg
+			}
+		}()
+	})
+	return s.messages
+}
+
 func (c *Client) ExecuteStreaming(req *abi.CommandRequest) (*StreamResult, error) {
-	stream, err := c.session.Open()
-	if err != nil {
+	return c.ExecuteStreamingContext(context.Background(), req)
+}
+
+// ExecuteStreamingContext runs req through the stream interceptors and then
+// streams the responses until the server ends the stream or ctx is done.
+func (c *Client) ExecuteStreamingContext(ctx context.Context, req *abi.CommandRequest) (*StreamResult, error) {
+	return c.streamInvoker(ctx, req)
+}
+
+func (c *Client) executeStreaming(ctx context.Context, req *abi.CommandRequest) (*StreamResult, error) {
+	if err := c.checkCommand(req); err != nil {
 		return nil, err
 	}
-	protoReq, err := c.codec.Encode(req)
+	stream, id, stop, err := c.openStreaming(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	var ch = make(chan *abi.CommandResponse, 10)
+	res := &StreamResult{
+		id:          id,
+		ch:          ch,
+		stream:      stream,
+		unsubscribe: c.unsubscriber(req, id),
+	}
+	go func() {
+		defer close(ch)
+		res.setErr(c.receive(ctx, stream, stop, func(resp *abi.CommandResponse) bool {
+			select {
+			case ch <- resp:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}))
+	}()
+	return res, nil
+}
+
+func (c *Client) unsubscriber(req *abi.CommandRequest, id uint32) func(context.Context) error {
+	switch x := req.RequestData.(type) {
+	case *abi.CommandRequest_Subscribe:
+		return func(ctx context.Context) error {
+			return c.Unsubscribe(ctx, x.Subscribe.Topic, id)
+		}
+	case *abi.CommandRequest_Psubscribe:
+		return func(ctx context.Context) error {
+			return c.Punsubscribe(ctx, x.Psubscribe.Pattern, id)
+		}
+	}
+	return nil
+}
+
+// openStreaming sends req on a new stream and reads back the stream id. The
+// returned func stops closing the stream once ctx is done.
+func (c *Client) openStreaming(ctx context.Context, req *abi.CommandRequest) (*stream, uint32, func(), error) {
+	req = withDeadline(ctx, req)
+	stream, err := c.open()
+	if err != nil {
+		return nil, 0, nil, err
+	}
 	c.logger.Debugf("write streaming req: %#v", req)
-	_, err = stream.Write(protoReq)
+	err = stream.send(req)
 	if err != nil {
-		return nil, err
+		stream.Close()
+		return nil, 0, nil, err
 	}
 
-	var ch = make(chan *abi.CommandResponse, 10)
-	resp, err := c.codec.Decode(stream)
+	stop := closeOnDone(ctx, stream)
+	resp, err := stream.recv()
+	if err == nil {
+		err = checkStatus(resp)
+	}
 	if err != nil {
 		c.logger.Errorw("failed to decode id", "err", err)
-		return nil, err
+		stop()
+		stream.Close()
+		return nil, 0, nil, contextErr(ctx, err)
 	}
 	id := resp.Values[0].GetInteger()
 	c.logger.Debugw("get id success", "id", id)
+	heartbeat := req.GetSubscribe().GetHeartbeat()
+	if heartbeat == nil {
+		heartbeat = req.GetPsubscribe().GetHeartbeat()
+	}
+	if heartbeat != nil {
+		stream.heartbeat = heartbeat.AsDuration()
+	}
+	return stream, uint32(id), stop, nil
+}
 
-	go func() {
-		for {
-			resp, err := c.codec.Decode(stream)
-			if err != nil {
-				c.logger.Errorw("failed to decode", "err", err, "eof", err == io.EOF)
-				close(ch)
-				break
-			}
-			if resp.Status == 0 {
-				c.logger.Info("receive cancel")
-				close(ch)
-				break
+// receive hands the responses of stream to deliver until the server ends the
+// stream or deliver returns false. It returns nil when the client
+// unsubscribed, see StreamResult.Err for the other cases. The stream is closed
+// once receive returns, or earlier when no frame came for MISSED_HEARTBEATS
+// intervals.
+func (c *Client) receive(ctx context.Context, stream *stream, stop func(), deliver func(*abi.CommandResponse) bool) error {
+	defer stream.Close()
+	defer stop()
+	var missed int32
+	var timer *time.Timer
+	if stream.heartbeat > 0 {
+		timer = time.AfterFunc(MISSED_HEARTBEATS*stream.heartbeat, func() {
+			atomic.StoreInt32(&missed, 1)
+			stream.Close()
+		})
+		defer timer.Stop()
+	}
+	for {
+		// only armed while waiting on the server, deliver blocking on a slow
+		// consumer doesn't make the stream dead
+		if timer != nil {
+			timer.Reset(MISSED_HEARTBEATS * stream.heartbeat)
+		}
+		resp, err := stream.recv()
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			c.logger.Errorw("failed to decode", "err", err, "eof", err == io.EOF, "poisoned", stream.err != nil)
+			if atomic.LoadInt32(&missed) == 1 {
+				return ErrHeartbeatMissed
 			}
-			ch <- resp
-			c.logger.Debugw("get streaming resp", "resp", resp)
+			return contextErr(ctx, err)
 		}
-	}()
-	return &StreamResult{
-		id: uint32(id),
-		ch: ch,
-	}, nil
+		switch {
+		case resp.Frame == abi.StreamFrame_HEARTBEAT:
+			continue
+		case resp.Frame == abi.StreamFrame_END:
+			c.logger.Infow("receive end", "reason", resp.EndReason)
+			return endError(resp)
+		case resp.Status == 0:
+			// older servers end streams without a reason
+			c.logger.Info("receive cancel")
+			return nil
+		}
+		if !deliver(resp) {
+			return ctx.Err()
+		}
+		c.logger.Debugw("get streaming resp", "resp", resp)
+	}
 }
 
 func (c *Client) Execute(req *abi.CommandRequest) (*abi.CommandResponse, error) {
-	stream, err := c.session.Open()
-	if err != nil {
+	return c.ExecuteContext(context.Background(), req)
+}
+
+// ExecuteContext runs req through the interceptors and waits for its response.
+// The deadline of ctx is sent along with the request and ctx being done
+// abandons the stream.
+func (c *Client) ExecuteContext(ctx context.Context, req *abi.CommandRequest) (*abi.CommandResponse, error) {
+	return c.invoker(ctx, req)
+}
+
+func (c *Client) execute(ctx context.Context, req *abi.CommandRequest) (*abi.CommandResponse, error) {
+	if err := c.checkCommand(req); err != nil {
 		return nil, err
 	}
-	protoReq, err := c.codec.Encode(req)
+	req = withDeadline(ctx, req)
+	stream, err := c.open()
 	if err != nil {
 		return nil, err
 	}
+	defer stream.Close()
+	defer closeOnDone(ctx, stream)()
 	c.logger.Debugf("write req: %#v", req)
-	_, err = stream.Write(protoReq)
+	err = stream.send(req)
 	if err != nil {
-		return nil, err
+		return nil, contextErr(ctx, err)
 	}
-	resp, err := c.codec.Decode(stream)
+	resp, err := stream.recv()
 	if err != nil {
-		return nil, err
+		return nil, contextErr(ctx, err)
 	}
 	c.logger.Debugw("get resp", "resp", resp)
 	return resp, nil
 }
 
+// withDeadline returns req carrying the deadline of ctx, unless it already has
+// one. The caller's request is left untouched so it can be reused, the copy
+// shares its command.
+func withDeadline(ctx context.Context, req *abi.CommandRequest) *abi.CommandRequest {
+	deadline, ok := ctx.Deadline()
+	if !ok || req.Deadline != nil {
+		return req
+	}
+	clone := *req
+	clone.Deadline = timestamppb.New(deadline)
+	return &clone
+}
+
+// closeOnDone closes stream once ctx is done, unblocking its reads. The
+// returned func stops watching ctx.
+func closeOnDone(ctx context.Context, stream io.Closer) func() {
+	if ctx.Done() == nil {
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Close()
+		case <-done:
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// contextErr prefers the context error over the one caused by closing the stream.
+func contextErr(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
 func NewTlsConfig() (*tls.Config, error) {
 	key, err := parseKey("../certs/client.key")
 	if err != nil {
@@ -133,15 +382,74 @@ func NewTlsConfig() (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
+// NewClient sends Hello over the session, see Client.Server.
 func NewClient(logger *zap.SugaredLogger, codec kvgo.Codec, session multiplex.Session) (client *Client, err error) {
 	client = &Client{
 		logger:  logger,
 		codec:   codec,
 		session: session,
 	}
+	client.invoker = client.execute
+	client.streamInvoker = client.executeStreaming
+	if n, ok := codec.(kvgo.Negotiable); ok {
+		client.compressions = n.Compressions()
+		client.checksum = n.Checksum()
+	}
+	client.sessionCodec.Store(client.legacyCodec())
+	if err = client.sayHello(); err != nil {
+		return nil, err
+	}
 	return
 }
 
+// Negotiate agrees on the frame compression and checksums with the server,
+// when the codec supports it. Streams opened afterwards use the agreed
+// options, those already open keep theirs.
+func (c *Client) Negotiate() error {
+	n, ok := c.codec.(kvgo.Negotiable)
+	if !ok {
+		return nil
+	}
+	offered := c.compressions
+	req := &abi.Negotiate{
+		Compressions: make([]abi.Compression, len(offered)),
+		Checksum:     c.checksum,
+	}
+	for i, compression := range offered {
+		req.Compressions[i] = abi.Compression(compression)
+	}
+	resp, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Negotiate{Negotiate: req}})
+	if err != nil {
+		return err
+	}
+
+	compression := kvgo.CompressionNone
+	for _, v := range resp.Values {
+		for _, o := range offered {
+			if kvgo.Compression(v.GetInteger()) == o {
+				compression = o
+				break
+			}
+		}
+		if compression != kvgo.CompressionNone {
+			break
+		}
+	}
+	checksum := false
+	for _, pair := range resp.Pairs {
+		if pair.Key == "checksum" {
+			checksum = req.Checksum && pair.Value.GetBool()
+		}
+	}
+	c.sessionCodec.Store(n.Negotiated(compression, checksum))
+	c.logger.Debugw("negotiated", "compression", compression, "checksum", checksum)
+	return nil
+}
+
+func (c *Client) currentCodec() kvgo.Codec {
+	return c.sessionCodec.Load().(kvgo.Codec)
+}
+
 func parseCertificate(crt string) (*x509.Certificate, error) {
 	certPEMBlock, err := ioutil.ReadFile(crt)
 	if err != nil {
@@ -0,0 +1,326 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	kvgo "github.com/caelansar/kv-go"
+	"github.com/caelansar/kv-go/client/multiplex"
+	abi "github.com/caelansar/kv-go/pb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+// fakeSession serves every opened stream in memory with handler, which
+// answers a request through send and returns once the stream is done.
+type fakeSession struct {
+	handler func(req *abi.CommandRequest, send func(*abi.CommandResponse))
+	// checksum frames in both directions, corrupt flips a bit of every response
+	checksum bool
+	corrupt  bool
+}
+
+func (f *fakeSession) Open() (io.ReadWriteCloser, error) {
+	client, server := net.Pipe()
+	go serve(server, f.handler, f.checksum, f.corrupt)
+	return client, nil
+}
+
+// openStreams counts the streams of Session not closed yet.
+type openStreams struct {
+	multiplex.Session
+	open int32
+}
+
+func (s *openStreams) Open() (io.ReadWriteCloser, error) {
+	conn, err := s.Session.Open()
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt32(&s.open, 1)
+	return &countedStream{ReadWriteCloser: conn, open: &s.open}, nil
+}
+
+func (s *openStreams) Count() int32 {
+	return atomic.LoadInt32(&s.open)
+}
+
+type countedStream struct {
+	io.ReadWriteCloser
+	once sync.Once
+	open *int32
+}
+
+func (s *countedStream) Close() error {
+	s.once.Do(func() { atomic.AddInt32(s.open, -1) })
+	return s.ReadWriteCloser.Close()
+}
+
+// serve answers the request read from conn with handler, then closes conn.
+func serve(conn io.ReadWriteCloser, handler func(*abi.CommandRequest, func(*abi.CommandResponse)), checksum, corrupt bool) {
+	defer conn.Close()
+	req, err := readRequest(conn, checksum)
+	if err != nil {
+		return
+	}
+	handler(req, func(resp *abi.CommandResponse) {
+		frame, err := encodeResponse(resp)
+		if err != nil {
+			return
+		}
+		if checksum {
+			var crc [kvgo.CHECKSUM]byte
+			binary.BigEndian.PutUint32(crc[:], crc32.Checksum(frame, crc32.MakeTable(crc32.Castagnoli)))
+			frame = append(frame, crc[:]...)
+		}
+		if corrupt {
+			frame[kvgo.LENGTH] ^= 1
+		}
+		conn.Write(frame)
+	})
+}
+
+func readRequest(r io.Reader, checksum bool) (*abi.CommandRequest, error) {
+	var header uint32
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, err
+	}
+	// like servers predating negotiation, algorithm bits aren't understood
+	length := header &^ kvgo.COMPRESSION_BIT
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	if checksum {
+		if _, err := io.ReadFull(r, make([]byte, kvgo.CHECKSUM)); err != nil {
+			return nil, err
+		}
+	}
+	// the fake server only understands legacy gzip, see TestNegotiate
+	if header&kvgo.COMPRESSION_BIT != 0 {
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(gr); err != nil {
+			return nil, err
+		}
+	}
+	req := &abi.CommandRequest{}
+	return req, proto.Unmarshal(data, req)
+}
+
+func encodeResponse(resp *abi.CommandResponse) ([]byte, error) {
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(len(data)))
+	b.Write(data)
+	return b.Bytes(), nil
+}
+
+// memStore implements the hash commands on top of nested maps.
+type memStore struct {
+	mu     sync.Mutex
+	tables map[string]map[string]*abi.Value
+}
+
+func (s *memStore) handle(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tables == nil {
+		s.tables = make(map[string]map[string]*abi.Value)
+	}
+	table := func(name string) map[string]*abi.Value {
+		t, ok := s.tables[name]
+		if !ok {
+			t = make(map[string]*abi.Value)
+			s.tables[name] = t
+		}
+		return t
+	}
+	ok := &abi.CommandResponse{Status: 200}
+
+	switch x := req.RequestData.(type) {
+	case *abi.CommandRequest_Hget:
+		v, found := table(x.Hget.Table)[x.Hget.Key]
+		if !found {
+			send(&abi.CommandResponse{Status: 404, Message: "Not found"})
+			return
+		}
+		ok.Values = []*abi.Value{v}
+	case *abi.CommandRequest_Hgetall:
+		for k, v := range table(x.Hgetall.Table) {
+			ok.Pairs = append(ok.Pairs, &abi.Kvpair{Key: k, Value: v})
+		}
+	case *abi.CommandRequest_Hset:
+		table(x.Hset.Table)[x.Hset.Pair.Key] = x.Hset.Pair.Value
+	case *abi.CommandRequest_Hmset:
+		for _, pair := range x.Hmset.Pairs {
+			table(x.Hmset.Table)[pair.Key] = pair.Value
+		}
+	case *abi.CommandRequest_Hdel:
+		delete(table(x.Hdel.Table), x.Hdel.Key)
+	case *abi.CommandRequest_Hexist:
+		_, found := table(x.Hexist.Table)[x.Hexist.Key]
+		ok.Values = []*abi.Value{{Value: &abi.Value_Bool{Bool: found}}}
+	default:
+		send(&abi.CommandResponse{Status: 400, Message: "unsupported"})
+		return
+	}
+	send(ok)
+}
+
+func newTestClient(t *testing.T, handler func(*abi.CommandRequest, func(*abi.CommandResponse))) *Client {
+	t.Helper()
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, &fakeSession{handler: handler})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func helloResponse(commands ...string) *abi.CommandResponse {
+	list := &abi.ValueList{}
+	for _, c := range commands {
+		list.Values = append(list.Values, &abi.Value{Value: &abi.Value_String_{String_: c}})
+	}
+	return &abi.CommandResponse{Status: 200, Pairs: []*abi.Kvpair{
+		{Key: "version", Value: &abi.Value{Value: &abi.Value_Integer{Integer: kvgo.PROTOCOL_VERSION}}},
+		{Key: "commands", Value: &abi.Value{Value: &abi.Value_List{List: list}}},
+		{Key: "max_frame_size", Value: &abi.Value{Value: &abi.Value_Integer{Integer: kvgo.MAX_FRAME_SIZE}}},
+		{Key: "server_id", Value: &abi.Value{Value: &abi.Value_String_{String_: "test"}}},
+	}}
+}
+
+func TestHello(t *testing.T) {
+	var opened int
+	store := &memStore{}
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		opened++
+		if req.GetHello() != nil {
+			if req.GetHello().Version != kvgo.PROTOCOL_VERSION {
+				t.Errorf("unexpected version %d", req.GetHello().Version)
+			}
+			send(helloResponse("hget", "hset"))
+			return
+		}
+		store.handle(req, send)
+	})
+
+	want := &ServerHello{Version: kvgo.PROTOCOL_VERSION, Commands: []string{"hget", "hset"}, MaxFrameSize: kvgo.MAX_FRAME_SIZE, ServerID: "test"}
+	if !reflect.DeepEqual(c.Server(), want) {
+		t.Fatalf("got %+v, want %+v", c.Server(), want)
+	}
+	if err := c.Hset("t", "k", &abi.Value{Value: &abi.Value_Bool{Bool: true}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Hdel("t", "k"); !errors.Is(err, ErrUnsupportedCommand) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if opened != 2 {
+		t.Fatalf("unsupported command reached the server, %d streams opened", opened)
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	var offered *abi.Negotiate
+	codec := &kvgo.DefaultCodec{Compression: kvgo.CompressionZstd, Crc32c: true}
+	c, err := NewClient(zap.NewNop().Sugar(), codec, &fakeSession{handler: func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("negotiate"))
+			return
+		}
+		offered = req.GetNegotiate()
+		send(&abi.CommandResponse{
+			Status: 200,
+			Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: int64(abi.Compression_GZIP)}}},
+		})
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(offered.GetCompressions()) == 0 || offered.Compressions[0] != abi.Compression_ZSTD || !offered.Checksum {
+		t.Fatalf("unexpected offer: %v", offered)
+	}
+	negotiated := c.currentCodec().(*kvgo.DefaultCodec)
+	if negotiated.Compression != kvgo.CompressionGzip {
+		t.Fatalf("negotiated %s", negotiated.Compression)
+	}
+	if negotiated.Crc32c {
+		t.Fatal("checksum enabled without the server agreeing")
+	}
+	if codec.Compression != kvgo.CompressionZstd || !codec.Crc32c {
+		t.Fatalf("codec given to NewClient modified: %+v", codec)
+	}
+
+	// servers that can't negotiate only read legacy gzip frames
+	store := &memStore{}
+	large := &abi.Value{Value: &abi.Value_String_{String_: strings.Repeat("a", 5<<10)}}
+	for _, handler := range []func(*abi.CommandRequest, func(*abi.CommandResponse)){
+		store.handle,
+		func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+			if req.GetHello() != nil {
+				send(helloResponse("hset"))
+				return
+			}
+			store.handle(req, send)
+		},
+	} {
+		c, err := NewClient(zap.NewNop().Sugar(), codec, &fakeSession{handler: handler})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if legacy := c.currentCodec().(*kvgo.DefaultCodec); legacy.Compression != kvgo.CompressionGzip || legacy.Crc32c {
+			t.Fatalf("not negotiated, got %+v", legacy)
+		}
+		if err = c.Hset("t", "k", large); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCorruptFramePoisonsStream(t *testing.T) {
+	session := &fakeSession{handler: (&memStore{}).handle}
+	codec := &kvgo.DefaultCodec{}
+	c, err := NewClient(zap.NewNop().Sugar(), codec, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// as if negotiated
+	c.sessionCodec.Store(codec.Negotiated(codec.Compression, true))
+	session.checksum = true
+	if err = c.Hset("t", "k", &abi.Value{Value: &abi.Value_Bool{Bool: true}}); err != nil {
+		t.Fatal(err)
+	}
+
+	session.corrupt = true
+	s, err := c.open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.send(&abi.CommandRequest{RequestData: &abi.CommandRequest_Hget{Hget: &abi.Hget{Table: "t", Key: "k"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.recv(); !errors.Is(err, kvgo.ErrCorruptFrame) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err = s.recv(); !errors.Is(err, kvgo.ErrCorruptFrame) {
+		t.Fatalf("stream not poisoned: %v", err)
+	}
+	if err = s.send(&abi.CommandRequest{}); !errors.Is(err, kvgo.ErrCorruptFrame) {
+		t.Fatalf("stream not poisoned: %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// WithFilter only delivers the messages matching f, which the server
+// evaluates so that the others never cross the wire. Filters are built with
+// Where, All, Any and Not, e.g.
+//
+//	WithFilter(Where("0.tenant", abi.Op_EQ, &abi.Value{Value: &abi.Value_String_{String_: tenant}}))
+func WithFilter(f *abi.Filter) SubscribeOption {
+	return func(s *abi.Subscribe) {
+		s.Filter = f
+	}
+}
+
+// Where compares the value at path with value, see abi.Condition for the path
+// syntax.
+func Where(path string, op abi.Op, value *abi.Value) *abi.Filter {
+	return &abi.Filter{Expr: &abi.Filter_Condition{Condition: &abi.Condition{Path: path, Op: op, Value: value}}}
+}
+
+func All(filters ...*abi.Filter) *abi.Filter {
+	return &abi.Filter{Expr: &abi.Filter_All{All: &abi.FilterList{Filters: filters}}}
+}
+
+func Any(filters ...*abi.Filter) *abi.Filter {
+	return &abi.Filter{Expr: &abi.Filter_Any{Any: &abi.FilterList{Filters: filters}}}
+}
+
+func Not(f *abi.Filter) *abi.Filter {
+	return &abi.Filter{Expr: &abi.Filter_Not{Not: f}}
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// InGroup joins the consumer group, each message then goes to one member only
+// and must be acknowledged, see Client.Consume.
+func InGroup(group string) SubscribeOption {
+	return func(s *abi.Subscribe) {
+		s.Group = group
+	}
+}
+
+// GroupConfig holds the delivery settings of a consumer group, zero fields
+// keep the server defaults.
+type GroupConfig struct {
+	// VisibilityTimeout is how long a member has to acknowledge a message
+	// before it is redelivered.
+	VisibilityTimeout time.Duration
+	// MaxDeliveries after which a message goes to DeadLetterTopic.
+	MaxDeliveries   uint32
+	DeadLetterTopic string
+}
+
+func (c *Client) ConfigureGroup(ctx context.Context, topic, group string, config GroupConfig) error {
+	req := &abi.ConfigureGroup{
+		Topic:           topic,
+		Group:           group,
+		MaxDeliveries:   config.MaxDeliveries,
+		DeadLetterTopic: config.DeadLetterTopic,
+	}
+	if config.VisibilityTimeout > 0 {
+		req.VisibilityTimeout = durationpb.New(config.VisibilityTimeout)
+	}
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_ConfigureGroup{ConfigureGroup: req}})
+	return err
+}
+
+// Ack marks the messages ids of group as processed.
+func (c *Client) Ack(ctx context.Context, topic, group string, ids ...uint64) error {
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Ack{
+		Ack: &abi.Ack{Topic: topic, Group: group, Ids: ids},
+	}})
+	return err
+}
+
+// Nack hands the messages ids of group back for redelivery.
+func (c *Client) Nack(ctx context.Context, topic, group string, ids ...uint64) error {
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Nack{
+		Nack: &abi.Nack{Topic: topic, Group: group, Ids: ids},
+	}})
+	return err
+}
+
+// ACK_TIMEOUT bounds the Ack or Nack sent by Consume once a handler returned,
+// they don't use the consumer ctx so that a message processed right before
+// shutdown is still acknowledged.
+const ACK_TIMEOUT = 5 * time.Second
+
+// Handler processes a message of a consumer group, returning an error nacks it.
+type Handler func(ctx context.Context, msg *abi.Message) error
+
+// Consume joins group on topic and runs handler on workers goroutines for
+// each message delivered, acking the message when it returns nil and nacking
+// it otherwise. It blocks until ctx is done, returning ctx.Err(), or the
+// subscription ends, returning StreamResult.Err.
+func (c *Client) Consume(ctx context.Context, topic, group string, workers int, handler Handler, opts ...SubscribeOption) error {
+	res, err := c.Subscribe(ctx, topic, append(opts[:len(opts):len(opts)], InGroup(group))...)
+	if err != nil {
+		return err
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for msg := range res.Messages() {
+				err := handler(ctx, msg)
+				ackCtx, cancel := context.WithTimeout(context.Background(), ACK_TIMEOUT)
+				if err != nil {
+					c.logger.Warnw("message not processed", "topic", topic, "group", group, "id", msg.Id, "delivery", msg.Delivery, "err", err)
+					err = c.Nack(ackCtx, topic, group, msg.Id)
+				} else {
+					err = c.Ack(ackCtx, topic, group, msg.Id)
+				}
+				cancel()
+				if err != nil {
+					// the visibility timeout will redeliver it
+					c.logger.Errorw("failed to acknowledge", "topic", topic, "group", group, "id", msg.Id, "err", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return res.Err()
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	kvgo "github.com/caelansar/kv-go"
+	abi "github.com/caelansar/kv-go/pb"
+	"go.uber.org/zap"
+)
+
+func TestConsume(t *testing.T) {
+	b := &broker{}
+	c := newTestClient(t, b.handle)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := c.ConfigureGroup(ctx, "orders", "billing", GroupConfig{MaxDeliveries: 2, DeadLetterTopic: "orders.dead"}); err != nil {
+		t.Fatal(err)
+	}
+	dead, err := c.Subscribe(ctx, "orders.dead")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	handled := make(map[string][]uint32)
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Consume(ctx, "orders", "billing", 2, func(ctx context.Context, msg *abi.Message) error {
+				mu.Lock()
+				defer mu.Unlock()
+				payload := msg.Payload[0].GetString_()
+				handled[payload] = append(handled[payload], msg.Delivery)
+				if payload == "bad" {
+					return errors.New("can't bill")
+				}
+				return nil
+			})
+		}()
+	}
+	waitFor(t, func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return len(b.subs) == 3
+	})
+
+	for _, payload := range []string{"a", "bad", "b", "c"} {
+		if _, err = c.Publish(ctx, "orders", &abi.Value{Value: &abi.Value_String_{String_: payload}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case msg := <-dead.Messages():
+		if msg.Payload[0].GetString_() != "bad" {
+			t.Fatalf("unexpected dead letter %v", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no dead letter")
+	}
+	waitFor(t, func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return len(b.acked) == 3
+	})
+	cancel()
+	wg.Wait()
+
+	b.mu.Lock()
+	acked := append([]uint64(nil), b.acked...)
+	b.mu.Unlock()
+	sort.Slice(acked, func(i, j int) bool { return acked[i] < acked[j] })
+	if !reflect.DeepEqual(acked, []uint64{1, 3, 4}) {
+		t.Fatalf("unexpected acks %v", acked)
+	}
+	for _, payload := range []string{"a", "b", "c"} {
+		if len(handled[payload]) != 1 {
+			t.Fatalf("%s handled %d times", payload, len(handled[payload]))
+		}
+	}
+	if d := handled["bad"]; len(d) != 2 || d[0] != 1 || d[1] != 2 {
+		t.Fatalf("unexpected deliveries of bad %v", d)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPublishWait(t *testing.T) {
+	b := &broker{}
+	c := newTestClient(t, b.handle)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// without consumers nobody acknowledges
+	receipt, err := c.PublishWait(ctx, "orders", 1, 10*time.Millisecond)
+	if !errors.Is(err, ErrAckTimeout) || receipt.Id != 1 || receipt.Delivered != 0 {
+		t.Fatalf("unexpected receipt %+v %v", receipt, err)
+	}
+
+	for _, group := range []string{"billing", "shipping"} {
+		go c.Consume(ctx, "orders", group, 1, func(context.Context, *abi.Message) error { return nil })
+	}
+	waitFor(t, func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return len(b.subs) == 2
+	})
+	receipt, err = c.PublishWait(ctx, "orders", 2, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (PublishReceipt{Id: 2, Delivered: 2, Acks: 2}); *receipt != want {
+		t.Fatalf("got receipt %+v, want %+v", receipt, want)
+	}
+}
+
+func TestConsumeEnd(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	acked := make(chan uint64, 1)
+	session := &openStreams{Session: &fakeSession{handler: func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		switch {
+		case req.GetHello() != nil:
+			send(helloResponse("subscribe", "ack", "nack"))
+		case req.GetAck() != nil:
+			acked <- req.GetAck().Ids[0]
+			send(&abi.CommandResponse{Status: 200})
+		case req.GetSubscribe().GetTopic() == "kicked":
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+			send(&abi.CommandResponse{Frame: abi.StreamFrame_END, EndReason: abi.EndReason_KICKED})
+		default:
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 2}}}})
+			send(&abi.CommandResponse{Status: 200, Envelope: &abi.Message{Topic: "orders", Id: 7}})
+			<-done
+		}
+	}}}
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var end *StreamEndError
+	err = c.Consume(context.Background(), "kicked", "g", 1, func(context.Context, *abi.Message) error { return nil })
+	if !errors.As(err, &end) || end.Reason != abi.EndReason_KICKED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// the stream ended by the server isn't leaked
+	waitFor(t, func() bool { return session.Count() == 0 })
+
+	// the handler returns once the consumer is shutting down, its ack still goes out
+	ctx, cancel := context.WithCancel(context.Background())
+	err = c.Consume(ctx, "orders", "g", 1, func(context.Context, *abi.Message) error {
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("unexpected error %v", err)
+	}
+	select {
+	case id := <-acked:
+		if id != 7 {
+			t.Fatalf("acked %d", id)
+		}
+	default:
+		t.Fatal("message not acked")
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// ErrNotFound matches a StatusError with status 404 via errors.Is.
+var ErrNotFound = errors.New("kv: not found")
+
+// ErrPermissionDenied matches a StatusError with status 403 via errors.Is.
+var ErrPermissionDenied = errors.New("kv: permission denied")
+
+// StatusError is returned by the typed helpers when the server answers with a
+// non-2xx status.
+type StatusError struct {
+	Status  uint32
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("kv: status %d: %s", e.Status, e.Message)
+}
+
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == 404
+	case ErrPermissionDenied:
+		return e.Status == 403
+	}
+	return false
+}
+
+func checkStatus(resp *abi.CommandResponse) error {
+	if resp.Status < 200 || resp.Status >= 300 {
+		return &StatusError{Status: resp.Status, Message: resp.Message}
+	}
+	return nil
+}
+
+// call executes req and turns a non-2xx status into a StatusError.
+func (c *Client) call(ctx context.Context, req *abi.CommandRequest) (*abi.CommandResponse, error) {
+	resp, err := c.ExecuteContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkStatus(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) Hmset(table string, pairs []*abi.Kvpair) error {
+	_, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Hmset{
+		Hmset: &abi.Hmset{Table: table, Pairs: pairs},
+	}})
+	return err
+}
+
+func (c *Client) Hgetall(table string) ([]*abi.Kvpair, error) {
+	resp, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Hgetall{
+		Hgetall: &abi.Hgetall{Table: table},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Pairs, nil
+}
+
+// HSetStruct stores v in table with one key per struct field, see Marshal.
+func (c *Client) HSetStruct(table string, v interface{}) error {
+	pairs, err := Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.Hmset(table, pairs)
+}
+
+// HGetStruct loads every key of table into the struct pointed to by v, see Unmarshal.
+func (c *Client) HGetStruct(table string, v interface{}) error {
+	pairs, err := c.Hgetall(table)
+	if err != nil {
+		return err
+	}
+	return Unmarshal(pairs, v)
+}
+
+func (c *Client) Hget(table, key string) (*abi.Value, error) {
+	resp, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Hget{
+		Hget: &abi.Hget{Table: table, Key: key},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Values) == 0 {
+		return nil, ErrNotFound
+	}
+	return resp.Values[0], nil
+}
+
+func (c *Client) Hset(table, key string, value *abi.Value) error {
+	_, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Hset{
+		Hset: &abi.Hset{Table: table, Pair: &abi.Kvpair{Key: key, Value: value}},
+	}})
+	return err
+}
+
+func (c *Client) Hdel(table, key string) error {
+	_, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Hdel{
+		Hdel: &abi.Hdel{Table: table, Key: key},
+	}})
+	return err
+}
+
+func (c *Client) Hexist(table, key string) (bool, error) {
+	resp, err := c.call(context.Background(), &abi.CommandRequest{RequestData: &abi.CommandRequest_Hexist{
+		Hexist: &abi.Hexist{Table: table, Key: key},
+	}})
+	if err != nil {
+		return false, err
+	}
+	if len(resp.Values) == 0 {
+		return false, nil
+	}
+	return resp.Values[0].GetBool(), nil
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	kvgo "github.com/caelansar/kv-go"
+	abi "github.com/caelansar/kv-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+var ErrUnsupportedCommand = errors.New("kv: command not supported by the server")
+
+// ServerHello is the server's answer to the Hello sent when the client is
+// created.
+type ServerHello struct {
+	Version      uint32
+	Commands     []string
+	MaxFrameSize int
+	ServerID     string
+}
+
+// Supports reports whether the server announced command, a field name of the
+// CommandRequest oneof such as "hget".
+func (h *ServerHello) Supports(command string) bool {
+	for _, c := range h.Commands {
+		if c == command {
+			return true
+		}
+	}
+	return false
+}
+
+// Server returns the server's Hello reply, nil if the server doesn't know Hello.
+func (c *Client) Server() *ServerHello {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.hello
+}
+
+// sayHello announces the client and, if the server supports it, negotiates the
+// frame options. Servers predating Hello are tolerated, then every command is
+// attempted. Callers switch the session to legacyCodec beforehand.
+func (c *Client) sayHello() error {
+	resp, err := c.Execute(&abi.CommandRequest{RequestData: &abi.CommandRequest_Hello{Hello: &abi.Hello{
+		Version:    kvgo.PROTOCOL_VERSION,
+		ClientName: filepath.Base(os.Args[0]),
+	}}})
+	if err != nil {
+		return err
+	}
+	if err = checkStatus(resp); err != nil {
+		c.logger.Warnw("server doesn't support hello", "err", err)
+		c.mu.Lock()
+		c.hello = nil
+		c.mu.Unlock()
+		return nil
+	}
+
+	hello := &ServerHello{}
+	for _, pair := range resp.Pairs {
+		switch pair.Key {
+		case "version":
+			hello.Version = uint32(pair.Value.GetInteger())
+		case "commands":
+			for _, v := range pair.Value.GetList().GetValues() {
+				hello.Commands = append(hello.Commands, v.GetString_())
+			}
+		case "max_frame_size":
+			hello.MaxFrameSize = int(pair.Value.GetInteger())
+		case "server_id":
+			hello.ServerID = pair.Value.GetString_()
+		}
+	}
+	c.mu.Lock()
+	c.hello = hello
+	c.mu.Unlock()
+	c.logger.Debugw("hello", "server", hello)
+
+	if hello.Supports("negotiate") {
+		return c.Negotiate()
+	}
+	return nil
+}
+
+// legacyCodec returns the codec to use until the server agreed on the frame
+// options, and with servers unable to: no checksums and gzip, whose frames
+// carry no algorithm bits, unless the codec doesn't compress at all.
+func (c *Client) legacyCodec() kvgo.Codec {
+	n, ok := c.codec.(kvgo.Negotiable)
+	if !ok {
+		return c.codec
+	}
+	compression := kvgo.CompressionGzip
+	if len(c.compressions) > 0 && c.compressions[0] == kvgo.CompressionNone {
+		compression = kvgo.CompressionNone
+	}
+	return n.Negotiated(compression, false)
+}
+
+// checkCommand fails fast on commands the server didn't announce.
+func (c *Client) checkCommand(req *abi.CommandRequest) error {
+	hello := c.Server()
+	if hello == nil {
+		return nil
+	}
+	name := commandName(req)
+	if name == "hello" || hello.Supports(name) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrUnsupportedCommand, name)
+}
+
+func commandName(req *abi.CommandRequest) string {
+	m := proto.MessageReflect(req)
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("request_data"))
+	if fd == nil {
+		return ""
+	}
+	return string(fd.Name())
+}
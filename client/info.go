@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// Info returns the server statistics of sections, every section if none is
+// given. Keys are `<section>.<name>`, see abi.Info.
+func (c *Client) Info(ctx context.Context, sections ...string) ([]*abi.Kvpair, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Info{Info: &abi.Info{Sections: sections}}})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Pairs, nil
+}
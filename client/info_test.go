@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+func TestInfo(t *testing.T) {
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("info"))
+			return
+		}
+		var pairs []*abi.Kvpair
+		for _, section := range req.GetInfo().Sections {
+			pairs = append(pairs, &abi.Kvpair{Key: section + ".x", Value: &abi.Value{Value: &abi.Value_Integer{Integer: 1}}})
+		}
+		send(&abi.CommandResponse{Status: 200, Pairs: pairs})
+	})
+	pairs, err := c.Info(context.Background(), "server", "pubsub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) != 2 || pairs[0].Key != "server.x" || pairs[1].Key != "pubsub.x" {
+		t.Fatalf("unexpected pairs %v", pairs)
+	}
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+
+	abi "github.com/caelansar/kv-go/pb"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Invoker sends a request and waits for its response.
+type Invoker func(ctx context.Context, req *abi.CommandRequest) (*abi.CommandResponse, error)
+
+// StreamInvoker sends a request and streams its responses.
+type StreamInvoker func(ctx context.Context, req *abi.CommandRequest) (*StreamResult, error)
+
+// Interceptor wraps ExecuteContext. It may fill req.Metadata or req.Deadline,
+// inspect the response, and must call next to send the request.
+type Interceptor func(ctx context.Context, req *abi.CommandRequest, next Invoker) (*abi.CommandResponse, error)
+
+// StreamInterceptor is the Interceptor of ExecuteStreamingContext.
+type StreamInterceptor func(ctx context.Context, req *abi.CommandRequest, next StreamInvoker) (*StreamResult, error)
+
+// Use appends interceptors, the first one added is the outermost. It must be
+// called before the client is used concurrently.
+func (c *Client) Use(interceptors ...Interceptor) {
+	c.interceptors = append(c.interceptors, interceptors...)
+	invoker := Invoker(c.execute)
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.interceptors[i], invoker
+		invoker = func(ctx context.Context, req *abi.CommandRequest) (*abi.CommandResponse, error) {
+			return interceptor(ctx, req, next)
+		}
+	}
+	c.invoker = invoker
+}
+
+// UseStream appends stream interceptors, see Use.
+func (c *Client) UseStream(interceptors ...StreamInterceptor) {
+	c.streamInterceptors = append(c.streamInterceptors, interceptors...)
+	invoker := StreamInvoker(c.executeStreaming)
+	for i := len(c.streamInterceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.streamInterceptors[i], invoker
+		invoker = func(ctx context.Context, req *abi.CommandRequest) (*StreamResult, error) {
+			return interceptor(ctx, req, next)
+		}
+	}
+	c.streamInvoker = invoker
+}
+
+// Well known metadata keys.
+const (
+	MetadataRequestID  = "request-id"
+	MetadataTraceID    = "trace-id"
+	MetadataAuthToken  = "authorization"
+	MetadataClientName = "client-name"
+)
+
+// withMetadata returns a copy of req whose metadata also holds the keys of md
+// it didn't have. Like withDeadline, the caller's request and its map are left
+// untouched so the request can be reused or shared.
+func withMetadata(req *abi.CommandRequest, md map[string]string) *abi.CommandRequest {
+	clone := *req
+	clone.Metadata = make(map[string]string, len(req.Metadata)+len(md))
+	for k, v := range md {
+		clone.Metadata[k] = v
+	}
+	for k, v := range req.Metadata {
+		clone.Metadata[k] = v
+	}
+	return &clone
+}
+
+// MetadataInterceptor adds md to every request, without overwriting keys
+// already set on the request.
+func MetadataInterceptor(md map[string]string) Interceptor {
+	return func(ctx context.Context, req *abi.CommandRequest, next Invoker) (*abi.CommandResponse, error) {
+		return next(ctx, withMetadata(req, md))
+	}
+}
+
+// MetadataStreamInterceptor is the MetadataInterceptor of streaming commands.
+func MetadataStreamInterceptor(md map[string]string) StreamInterceptor {
+	return func(ctx context.Context, req *abi.CommandRequest, next StreamInvoker) (*StreamResult, error) {
+		return next(ctx, withMetadata(req, md))
+	}
+}
+
+// RequestIDInterceptor tags every request with a random MetadataRequestID,
+// a new one each time a request is sent.
+func RequestIDInterceptor() Interceptor {
+	return func(ctx context.Context, req *abi.CommandRequest, next Invoker) (*abi.CommandResponse, error) {
+		return next(ctx, withMetadata(req, map[string]string{MetadataRequestID: uuid.NewV4().String()}))
+	}
+}
+
+// RequestIDStreamInterceptor is the RequestIDInterceptor of streaming commands.
+func RequestIDStreamInterceptor() StreamInterceptor {
+	return func(ctx context.Context, req *abi.CommandRequest, next StreamInvoker) (*StreamResult, error) {
+		return next(ctx, withMetadata(req, map[string]string{MetadataRequestID: uuid.NewV4().String()}))
+	}
+}
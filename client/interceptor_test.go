@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+func TestInterceptors(t *testing.T) {
+	received := make(chan *abi.CommandRequest, 1)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(&abi.CommandResponse{Status: 400})
+			return
+		}
+		received <- req
+		send(&abi.CommandResponse{Status: 200, Metadata: req.Metadata, Deadline: req.Deadline})
+	})
+
+	var order []string
+	trace := func(name string) Interceptor {
+		return func(ctx context.Context, req *abi.CommandRequest, next Invoker) (*abi.CommandResponse, error) {
+			order = append(order, name)
+			return next(ctx, req)
+		}
+	}
+	c.Use(trace("outer"), MetadataInterceptor(map[string]string{MetadataClientName: "test"}))
+	c.Use(RequestIDInterceptor(), trace("inner"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+	resp, err := c.ExecuteContext(ctx, &abi.CommandRequest{
+		RequestData: &abi.CommandRequest_Hget{Hget: &abi.Hget{Table: "t", Key: "k"}},
+		Metadata:    map[string]string{MetadataClientName: "override"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := <-received
+	if req.Metadata[MetadataClientName] != "override" || req.Metadata[MetadataRequestID] == "" {
+		t.Fatalf("unexpected metadata %v", req.Metadata)
+	}
+	if !req.Deadline.AsTime().Equal(deadline) {
+		t.Fatalf("deadline %v, want %v", req.Deadline.AsTime(), deadline)
+	}
+	if resp.Metadata[MetadataRequestID] != req.Metadata[MetadataRequestID] {
+		t.Fatal("metadata not echoed")
+	}
+	if len(order) != 2 || order[0] != "outer" || order[1] != "inner" {
+		t.Fatalf("unexpected order %v", order)
+	}
+}
+
+func TestExecuteContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(&abi.CommandResponse{Status: 400})
+			return
+		}
+		// never answers
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.ExecuteContext(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Hget{Hget: &abi.Hget{Table: "t", Key: "k"}}})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestDeadlineLeavesRequestUntouched(t *testing.T) {
+	received := make(chan *abi.CommandRequest, 1)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(&abi.CommandResponse{Status: 400})
+			return
+		}
+		received <- req
+		send(&abi.CommandResponse{Status: 200})
+	})
+	req := &abi.CommandRequest{RequestData: &abi.CommandRequest_Hget{Hget: &abi.Hget{Table: "t", Key: "k"}}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if _, err := c.ExecuteContext(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if (<-received).Deadline == nil || req.Deadline != nil {
+		t.Fatalf("deadline not sent or written into the request: %v", req.Deadline)
+	}
+	// reused without a deadline
+	if _, err := c.ExecuteContext(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if sent := <-received; sent.Deadline != nil {
+		t.Fatalf("stale deadline %v", sent.Deadline)
+	}
+}
+
+func TestMetadataLeavesRequestUntouched(t *testing.T) {
+	received := make(chan *abi.CommandRequest, 1)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(&abi.CommandResponse{Status: 400})
+			return
+		}
+		received <- req
+		send(&abi.CommandResponse{Status: 200})
+	})
+	c.Use(MetadataInterceptor(map[string]string{MetadataClientName: "test"}), RequestIDInterceptor())
+	req := &abi.CommandRequest{RequestData: &abi.CommandRequest_Hget{Hget: &abi.Hget{Table: "t", Key: "k"}}}
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		if _, err := c.ExecuteContext(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+		sent := <-received
+		if sent.Metadata[MetadataClientName] != "test" {
+			t.Fatalf("unexpected metadata %v", sent.Metadata)
+		}
+		ids = append(ids, sent.Metadata[MetadataRequestID])
+	}
+	if req.Metadata != nil {
+		t.Fatalf("metadata written into the request: %v", req.Metadata)
+	}
+	if ids[0] == "" || ids[0] == ids[1] {
+		t.Fatalf("request ids %v", ids)
+	}
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	received := make(chan *abi.CommandRequest, 1)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(&abi.CommandResponse{Status: 400})
+			return
+		}
+		received <- req
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		send(&abi.CommandResponse{Frame: abi.StreamFrame_END, EndReason: abi.EndReason_UNSUBSCRIBED})
+	})
+	c.UseStream(MetadataStreamInterceptor(map[string]string{MetadataClientName: "test", MetadataAuthToken: "secret"}), RequestIDStreamInterceptor())
+
+	res, err := c.Subscribe(context.Background(), "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range res.Chan() {
+	}
+	md := (<-received).Metadata
+	if md[MetadataClientName] != "test" || md[MetadataAuthToken] != "secret" || md[MetadataRequestID] == "" {
+		t.Fatalf("unexpected metadata %v", md)
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// KEYSPACE_PREFIX starts the reserved topics the server publishes key changes
+// on, when keyspace notifications are enabled.
+const KEYSPACE_PREFIX = "__keyspace__:"
+
+// Events of a KeyspaceEvent. Hmset and Hmdel publish one event per key.
+const (
+	KeyspaceSet     = "hset"
+	KeyspaceDel     = "hdel"
+	KeyspaceExpired = "expired"
+)
+
+// KeyspaceEvent reports a key changed in Table.
+type KeyspaceEvent struct {
+	Table string
+	Key   string
+	Event string
+}
+
+func KeyspaceTopic(table string) string {
+	return KEYSPACE_PREFIX + table
+}
+
+// SubscribeKeyspace subscribes to the changes of table, the responses are
+// decoded with ParseKeyspaceEvent.
+func (c *Client) SubscribeKeyspace(ctx context.Context, table string) (*StreamResult, error) {
+	return c.Subscribe(ctx, KeyspaceTopic(table))
+}
+
+func ParseKeyspaceEvent(resp *abi.CommandResponse) (*KeyspaceEvent, error) {
+	if len(resp.Values) != 1 || resp.Values[0].GetMap() == nil {
+		return nil, fmt.Errorf("kv: expected keyspace event map, got %v", resp.Values)
+	}
+	m := resp.Values[0].GetMap().Values
+	return &KeyspaceEvent{
+		Table: m["table"].GetString_(),
+		Key:   m["key"].GetString_(),
+		Event: m["event"].GetString_(),
+	}, nil
+}
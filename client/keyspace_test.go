@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+func TestSubscribeKeyspace(t *testing.T) {
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("subscribe"))
+			return
+		}
+		if req.GetSubscribe().Topic != "__keyspace__:t1" {
+			t.Errorf("unexpected topic %s", req.GetSubscribe().Topic)
+		}
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		for _, key := range []string{"k1", "k2"} {
+			event, _ := abi.ValueOf(map[string]string{"table": "t1", "key": key, "event": KeyspaceSet})
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{event}})
+		}
+		send(&abi.CommandResponse{})
+	})
+	res, err := c.SubscribeKeyspace(context.Background(), "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for resp := range res.Chan() {
+		e, err := ParseKeyspaceEvent(resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Table != "t1" || e.Event != KeyspaceSet {
+			t.Fatalf("unexpected event %+v", e)
+		}
+		keys = append(keys, e.Key)
+	}
+	if len(keys) != 2 || keys[0] != "k1" || keys[1] != "k2" {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// MonitorEvent is a command executed by the server, see Client.Monitor.
+type MonitorEvent struct {
+	Time    time.Time
+	Client  string
+	Table   string
+	Command string
+}
+
+// Monitor streams the commands executed by the server on tables, restricted to
+// the given command names when not empty. It fails with ErrPermissionDenied
+// unless the client has the admin ACL. Responses are decoded with
+// ParseMonitorEvent and the stream ends with ctx.
+func (c *Client) Monitor(ctx context.Context, tables, commands []string) (*StreamResult, error) {
+	return c.ExecuteStreamingContext(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Monitor{
+		Monitor: &abi.Monitor{Tables: tables, Commands: commands},
+	}})
+}
+
+func ParseMonitorEvent(resp *abi.CommandResponse) *MonitorEvent {
+	e := &MonitorEvent{}
+	for _, pair := range resp.Pairs {
+		switch pair.Key {
+		case "time":
+			if ts := pair.Value.GetTimestamp(); ts != nil {
+				e.Time = ts.AsTime()
+			}
+		case "client":
+			e.Client = pair.Value.GetString_()
+		case "table":
+			e.Table = pair.Value.GetString_()
+		case "command":
+			e.Command = pair.Value.GetString_()
+		}
+	}
+	return e
+}
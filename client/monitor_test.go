@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMonitor(t *testing.T) {
+	now := time.Now().UTC()
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("monitor"))
+			return
+		}
+		if len(req.GetMonitor().Tables) == 0 {
+			send(&abi.CommandResponse{Status: 403, Message: "admin only"})
+			return
+		}
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		send(&abi.CommandResponse{Status: 200, Pairs: []*abi.Kvpair{
+			{Key: "time", Value: &abi.Value{Value: &abi.Value_Timestamp{Timestamp: timestamppb.New(now)}}},
+			{Key: "client", Value: &abi.Value{Value: &abi.Value_String_{String_: "c1"}}},
+			{Key: "table", Value: &abi.Value{Value: &abi.Value_String_{String_: req.GetMonitor().Tables[0]}}},
+			{Key: "command", Value: &abi.Value{Value: &abi.Value_String_{String_: "hget"}}},
+		}})
+		send(&abi.CommandResponse{})
+	})
+
+	if _, err := c.Monitor(context.Background(), nil, nil); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	res, err := c.Monitor(context.Background(), []string{"t1"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []*MonitorEvent
+	for resp := range res.Chan() {
+		events = append(events, ParseMonitorEvent(resp))
+	}
+	want := MonitorEvent{Time: now, Client: "c1", Table: "t1", Command: "hget"}
+	if len(events) != 1 || *events[0] != want {
+		t.Fatalf("unexpected events %+v", events)
+	}
+}
@@ -13,7 +13,26 @@ type QuicSession struct {
 }
 
 func (q *QuicSession) Open() (io.ReadWriteCloser, error) {
-	return q.OpenStreamSync(context.Background())
+	s, err := q.OpenStreamSync(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return &QuicStream{Stream: s}, nil
+}
+
+// QuicStream closes both directions of the stream. quic.Stream.Close only
+// closes the write side, a pending Read would stay blocked.
+type QuicStream struct {
+	quic.Stream
+}
+
+func (s *QuicStream) Close() error {
+	s.CancelRead(0)
+	return s.Stream.Close()
+}
+
+func (q *QuicSession) Close() error {
+	return q.CloseWithError(0, "")
 }
 
 func NewQuicSession(addr string, tlsConfig *tls.Config) (session Session, err error) {
@@ -0,0 +1,59 @@
+package multiplex
+
+import (
+	"io"
+	"sync"
+)
+
+// Reconnector is implemented by sessions able to replace their connection.
+type Reconnector interface {
+	Reconnect() error
+}
+
+// ReconnectSession opens streams on the session returned by dial, and dials a
+// new one on Reconnect.
+type ReconnectSession struct {
+	dial    func() (Session, error)
+	mu      sync.RWMutex
+	session Session
+}
+
+func NewReconnectSession(dial func() (Session, error)) (*ReconnectSession, error) {
+	session, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	return &ReconnectSession{dial: dial, session: session}, nil
+}
+
+func (r *ReconnectSession) Open() (io.ReadWriteCloser, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.session.Open()
+}
+
+// Reconnect dials a new session and closes the previous one, failing the
+// streams still open on it. The previous session is kept if dialing fails.
+func (r *ReconnectSession) Reconnect() error {
+	session, err := r.dial()
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	old := r.session
+	r.session = session
+	r.mu.Unlock()
+	if closer, ok := old.(io.Closer); ok {
+		closer.Close()
+	}
+	return nil
+}
+
+func (r *ReconnectSession) Close() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if closer, ok := r.session.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/caelansar/kv-go/client/multiplex"
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+var ErrNotReconnectable = errors.New("kv: session can't reconnect")
+
+// Ping sends a Ping and returns the round-trip time.
+func (c *Client) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Ping{Ping: &abi.Ping{}}})
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// Echo returns the values as sent back by the server.
+func (c *Client) Echo(ctx context.Context, values ...*abi.Value) ([]*abi.Value, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Echo{Echo: &abi.Echo{Values: values}}})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Values, nil
+}
+
+// Reconnect replaces the session, when it implements multiplex.Reconnector,
+// and says Hello again to renegotiate the frame options.
+func (c *Client) Reconnect() error {
+	r, ok := c.session.(multiplex.Reconnector)
+	if !ok {
+		return ErrNotReconnectable
+	}
+	// back to the legacy options before the new session can be opened, the
+	// new server hasn't agreed to the previous ones
+	negotiated := c.currentCodec()
+	c.sessionCodec.Store(c.legacyCodec())
+	if err := r.Reconnect(); err != nil {
+		c.sessionCodec.Store(negotiated)
+		return err
+	}
+	return c.sayHello()
+}
+
+// HealthProber pings the server in the background, see Client.Probe.
+type HealthProber struct {
+	client    *Client
+	interval  time.Duration
+	timeout   time.Duration
+	threshold int
+	cancel    context.CancelFunc
+	done      chan struct{}
+
+	mu       sync.RWMutex
+	healthy  bool
+	failures int
+	rtt      time.Duration
+}
+
+// Probe pings the server every interval, each ping failing after timeout. After
+// threshold consecutive failures the session is marked unhealthy and, if it
+// supports it, reconnected, again every threshold failures; it turns healthy
+// with the next successful ping. Probing stops when ctx is done or Stop is
+// called, or right away if the server doesn't support Ping, the session then
+// stays healthy.
+func (c *Client) Probe(ctx context.Context, interval, timeout time.Duration, threshold int) *HealthProber {
+	if threshold < 1 {
+		threshold = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &HealthProber{
+		client:    c,
+		interval:  interval,
+		timeout:   timeout,
+		threshold: threshold,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		healthy:   true,
+	}
+	go p.run(ctx)
+	return p
+}
+
+func (p *HealthProber) run(ctx context.Context) {
+	defer close(p.done)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, p.timeout)
+		rtt, err := p.client.Ping(pingCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, ErrUnsupportedCommand) {
+			p.client.logger.Warnw("server can't be probed", "err", err)
+			return
+		}
+		if p.record(rtt, err) {
+			p.client.logger.Warnw("session unhealthy, reconnecting", "failures", p.threshold, "err", err)
+			if err := p.client.Reconnect(); err != nil {
+				p.client.logger.Errorw("failed to reconnect", "err", err)
+			}
+		}
+	}
+}
+
+// record reports whether the session just turned unhealthy.
+func (p *HealthProber) record(rtt time.Duration, err error) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		p.failures = 0
+		p.healthy = true
+		p.rtt = rtt
+		return false
+	}
+	// keep trying to reconnect every threshold failures
+	p.failures++
+	if p.failures%p.threshold == 0 {
+		p.healthy = false
+		return true
+	}
+	return false
+}
+
+func (p *HealthProber) Healthy() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.healthy
+}
+
+// RTT returns the round-trip time of the last successful ping.
+func (p *HealthProber) RTT() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.rtt
+}
+
+func (p *HealthProber) Stop() {
+	p.cancel()
+	<-p.done
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	kvgo "github.com/caelansar/kv-go"
+	"github.com/caelansar/kv-go/client/multiplex"
+	abi "github.com/caelansar/kv-go/pb"
+	"go.uber.org/zap"
+)
+
+func pingHandler(up bool) func(*abi.CommandRequest, func(*abi.CommandResponse)) {
+	return func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		switch {
+		case req.GetHello() != nil:
+			send(helloResponse("ping", "echo"))
+		case !up:
+			send(&abi.CommandResponse{Status: 503})
+		case req.GetEcho() != nil:
+			send(&abi.CommandResponse{Status: 200, Values: req.GetEcho().Values})
+		default:
+			send(&abi.CommandResponse{Status: 200})
+		}
+	}
+}
+
+func TestPingEcho(t *testing.T) {
+	c := newTestClient(t, pingHandler(true))
+	rtt, err := c.Ping(context.Background())
+	if err != nil || rtt <= 0 {
+		t.Fatalf("ping: %v %v", rtt, err)
+	}
+	values, err := c.Echo(context.Background(), &abi.Value{Value: &abi.Value_String_{String_: "hi"}})
+	if err != nil || len(values) != 1 || values[0].GetString_() != "hi" {
+		t.Fatalf("echo: %v %v", values, err)
+	}
+}
+
+func TestHealthProberReconnects(t *testing.T) {
+	var dials int32
+	session, err := multiplex.NewReconnectSession(func() (multiplex.Session, error) {
+		// the first session is down, the following ones are up
+		up := atomic.AddInt32(&dials, 1) > 1
+		return &fakeSession{handler: pingHandler(up)}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := c.Probe(context.Background(), time.Millisecond, time.Second, 3)
+	defer p.Stop()
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&dials) < 2 || !p.Healthy() || p.RTT() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("not reconnected: %d dials, healthy %v", atomic.LoadInt32(&dials), p.Healthy())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestReconnectWhileInUse(t *testing.T) {
+	store := &memStore{}
+	session, err := multiplex.NewReconnectSession(func() (multiplex.Session, error) {
+		return &fakeSession{handler: func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+			switch {
+			case req.GetHello() != nil:
+				send(helloResponse("negotiate", "hset"))
+			case req.GetNegotiate() != nil:
+				send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: int64(abi.Compression_GZIP)}}}})
+			default:
+				store.handle(req, send)
+			}
+		}}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{Compression: kvgo.CompressionZstd}, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			if err := c.Hset("t", "k", &abi.Value{Value: &abi.Value_Integer{Integer: int64(i)}}); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 5; i++ {
+		if err = c.Reconnect(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-done
+}
+
+func TestReconnectRenegotiates(t *testing.T) {
+	var c *Client
+	store := &memStore{}
+	session, err := multiplex.NewReconnectSession(func() (multiplex.Session, error) {
+		if c != nil {
+			if codec := c.currentCodec().(*kvgo.DefaultCodec); codec.Crc32c || codec.Compression != kvgo.CompressionGzip {
+				// dialed from Reconnect, on the test goroutine
+				t.Fatalf("new session dialed while streams open with %+v", codec)
+			}
+		}
+		s := &fakeSession{}
+		s.handler = func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+			switch {
+			case req.GetHello() != nil:
+				send(helloResponse("negotiate", "hset"))
+			case req.GetNegotiate() != nil:
+				// streams opened from now on carry checksums
+				s.checksum = true
+				send(&abi.CommandResponse{
+					Status: 200,
+					Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: int64(abi.Compression_GZIP)}}},
+					Pairs:  []*abi.Kvpair{{Key: "checksum", Value: &abi.Value{Value: &abi.Value_Bool{Bool: true}}}},
+				})
+			default:
+				store.handle(req, send)
+			}
+		}
+		return s, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, err = NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{Compression: kvgo.CompressionZstd, Crc32c: true}, session); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err = c.Reconnect(); err != nil {
+			t.Fatal(err)
+		}
+		if !c.currentCodec().(*kvgo.DefaultCodec).Crc32c {
+			t.Fatal("checksums not renegotiated")
+		}
+		if err = c.Hset("t", "k", &abi.Value{Value: &abi.Value_Integer{Integer: int64(i)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestHealthProberWithoutPing(t *testing.T) {
+	var dials int32
+	session, err := multiplex.NewReconnectSession(func() (multiplex.Session, error) {
+		atomic.AddInt32(&dials, 1)
+		return &fakeSession{handler: func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+			send(helloResponse("hget"))
+		}}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := c.Probe(context.Background(), time.Millisecond, time.Second, 1)
+	select {
+	case <-p.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("prober still running")
+	}
+	if !p.Healthy() || atomic.LoadInt32(&dials) != 1 {
+		t.Fatalf("healthy %v after %d dials", p.Healthy(), atomic.LoadInt32(&dials))
+	}
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrAckTimeout is returned by PublishWait when too few consumers acknowledged
+// the message in time.
+var ErrAckTimeout = errors.New("kv: timed out waiting for acks")
+
+// SubscribeOption customizes the Subscribe request, see Psubscribe for the
+// ones pattern subscriptions accept.
+type SubscribeOption func(*abi.Subscribe)
+
+// FromLatest only delivers the messages published after subscribing, it is
+// the default.
+func FromLatest() SubscribeOption {
+	return func(s *abi.Subscribe) {
+		s.Start = abi.StartPosition_LATEST
+	}
+}
+
+// FromEarliest replays every message the topic retains first.
+func FromEarliest() SubscribeOption {
+	return func(s *abi.Subscribe) {
+		s.Start = abi.StartPosition_EARLIEST
+	}
+}
+
+// FromOffset replays the retained messages from offset, e.g. the one after
+// the last message processed before a restart.
+func FromOffset(offset uint64) SubscribeOption {
+	return func(s *abi.Subscribe) {
+		s.Start = abi.StartPosition_OFFSET
+		s.Offset = offset
+	}
+}
+
+// WithHeartbeat asks the server for a heartbeat every interval the stream is
+// idle. The stream ends with ErrHeartbeatMissed after MISSED_HEARTBEATS
+// intervals without any frame.
+func WithHeartbeat(interval time.Duration) SubscribeOption {
+	return func(s *abi.Subscribe) {
+		s.Heartbeat = durationpb.New(interval)
+	}
+}
+
+// Subscribe streams the data published on topic until ctx is done or the
+// subscription is cancelled with Unsubscribe.
+func (c *Client) Subscribe(ctx context.Context, topic string, opts ...SubscribeOption) (*StreamResult, error) {
+	sub := &abi.Subscribe{Topic: topic}
+	for _, opt := range opts {
+		opt(sub)
+	}
+	return c.ExecuteStreamingContext(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Subscribe{
+		Subscribe: sub,
+	}})
+}
+
+// Retention bounds the messages kept by a topic, zero fields are unlimited.
+type Retention struct {
+	MaxMessages uint64
+	MaxAge      time.Duration
+	MaxBytes    uint64
+}
+
+// ConfigureTopic makes topic retain messages within retention, so that
+// subscribers can replay them with FromEarliest or FromOffset.
+func (c *Client) ConfigureTopic(ctx context.Context, topic string, retention Retention) error {
+	req := &abi.ConfigureTopic{
+		Topic:       topic,
+		MaxMessages: retention.MaxMessages,
+		MaxBytes:    retention.MaxBytes,
+	}
+	if retention.MaxAge > 0 {
+		req.MaxAge = durationpb.New(retention.MaxAge)
+	}
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_ConfigureTopic{ConfigureTopic: req}})
+	return err
+}
+
+// Unsubscribe ends the subscription id, its StreamResult channel is closed.
+func (c *Client) Unsubscribe(ctx context.Context, topic string, id uint32) error {
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Unsubscribe{
+		Unsubscribe: &abi.Unsubscribe{Topic: topic, Id: id},
+	}})
+	return err
+}
+
+// ErrPatternOption is returned by Psubscribe for the options only Subscribe
+// supports.
+var ErrPatternOption = errors.New("kv: only WithHeartbeat applies to pattern subscriptions")
+
+// Psubscribe streams the data published on every topic matching the glob
+// pattern, the topic of each response tells which one it was published on.
+// Among the options only WithHeartbeat applies, the others fail with
+// ErrPatternOption.
+func (c *Client) Psubscribe(ctx context.Context, pattern string, opts ...SubscribeOption) (*StreamResult, error) {
+	sub := &abi.Subscribe{}
+	for _, opt := range opts {
+		opt(sub)
+	}
+	if sub.Start != abi.StartPosition_LATEST || sub.Offset != 0 || sub.Group != "" || sub.Filter != nil {
+		return nil, ErrPatternOption
+	}
+	return c.ExecuteStreamingContext(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Psubscribe{
+		Psubscribe: &abi.Psubscribe{Pattern: pattern, Heartbeat: sub.Heartbeat},
+	}})
+}
+
+// Punsubscribe ends the pattern subscription id.
+func (c *Client) Punsubscribe(ctx context.Context, pattern string, id uint32) error {
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Punsubscribe{
+		Punsubscribe: &abi.Punsubscribe{Pattern: pattern, Id: id},
+	}})
+	return err
+}
+
+// PublishReceipt describes a published message, see Client.Publish.
+type PublishReceipt struct {
+	Id     uint64
+	Offset uint64
+	// Delivered is the number of subscribers the message was sent to.
+	Delivered uint32
+	// Acks received, only counted by PublishWait.
+	Acks uint32
+}
+
+func (c *Client) Publish(ctx context.Context, topic string, data ...*abi.Value) (*PublishReceipt, error) {
+	return c.publish(ctx, &abi.Publish{Topic: topic, Data: data})
+}
+
+// PublishWait publishes data and waits until acks consumers acknowledged it,
+// failing with ErrAckTimeout, along with the receipt, if they didn't within
+// timeout.
+func (c *Client) PublishWait(ctx context.Context, topic string, acks uint32, timeout time.Duration, data ...*abi.Value) (*PublishReceipt, error) {
+	receipt, err := c.publish(ctx, &abi.Publish{
+		Topic:       topic,
+		Data:        data,
+		WaitAcks:    acks,
+		WaitTimeout: durationpb.New(timeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Acks < acks {
+		return receipt, ErrAckTimeout
+	}
+	return receipt, nil
+}
+
+func (c *Client) publish(ctx context.Context, req *abi.Publish) (*PublishReceipt, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Publish{Publish: req}})
+	if err != nil {
+		return nil, err
+	}
+	receipt := &PublishReceipt{}
+	for _, pair := range resp.Pairs {
+		switch pair.Key {
+		case "id":
+			receipt.Id = uint64(pair.Value.GetInteger())
+		case "offset":
+			receipt.Offset = uint64(pair.Value.GetInteger())
+		case "delivered":
+			receipt.Delivered = uint32(pair.Value.GetInteger())
+		case "acks":
+			receipt.Acks = uint32(pair.Value.GetInteger())
+		}
+	}
+	return receipt, nil
+}
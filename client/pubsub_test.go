@@ -0,0 +1,547 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// broker fans published data out to the subscriptions matching its topic.
+// Topics configured with max_messages keep a log that Subscribe can replay.
+type broker struct {
+	mu     sync.Mutex
+	nextId int64
+	subs   map[int64]*subscription
+	// last message id of each topic, also its offset
+	ids    map[string]uint64
+	retain map[string]uint64
+	logs   map[string][]*abi.Message
+	// consumer groups: delivery settings, messages awaiting an ack and the
+	// member to deliver to next
+	groups  map[string]*abi.ConfigureGroup
+	pending map[string]map[uint64]*abi.Message
+	next    map[string]int
+	acked   []uint64
+}
+
+type subscription struct {
+	id      int64
+	pattern string
+	glob    bool
+	group   string
+	send    func(*abi.CommandResponse)
+	done    chan struct{}
+}
+
+func (b *broker) init() {
+	if b.subs == nil {
+		b.subs = make(map[int64]*subscription)
+		b.ids = make(map[string]uint64)
+		b.retain = make(map[string]uint64)
+		b.logs = make(map[string][]*abi.Message)
+		b.groups = make(map[string]*abi.ConfigureGroup)
+		b.pending = make(map[string]map[uint64]*abi.Message)
+		b.next = make(map[string]int)
+	}
+}
+
+func (b *broker) handle(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+	b.mu.Lock()
+	b.init()
+	b.mu.Unlock()
+	switch {
+	case req.GetHello() != nil:
+		send(helloResponse("subscribe", "unsubscribe", "psubscribe", "punsubscribe", "publish", "configure_topic", "configure_group", "ack", "nack"))
+	case req.GetSubscribe() != nil:
+		b.subscribe(req.GetSubscribe().Topic, false, req.GetSubscribe(), send)
+	case req.GetPsubscribe() != nil:
+		b.subscribe(req.GetPsubscribe().Pattern, true, &abi.Subscribe{}, send)
+	case req.GetUnsubscribe() != nil:
+		b.unsubscribe(int64(req.GetUnsubscribe().Id), send)
+	case req.GetPunsubscribe() != nil:
+		b.unsubscribe(int64(req.GetPunsubscribe().Id), send)
+	case req.GetConfigureTopic() != nil:
+		b.mu.Lock()
+		b.retain[req.GetConfigureTopic().Topic] = req.GetConfigureTopic().MaxMessages
+		b.mu.Unlock()
+		send(&abi.CommandResponse{Status: 200})
+	case req.GetConfigureGroup() != nil:
+		g := req.GetConfigureGroup()
+		b.mu.Lock()
+		b.groups[g.Topic+"/"+g.Group] = g
+		b.mu.Unlock()
+		send(&abi.CommandResponse{Status: 200})
+	case req.GetAck() != nil:
+		ack := req.GetAck()
+		b.mu.Lock()
+		for _, id := range ack.Ids {
+			delete(b.pending[ack.Topic+"/"+ack.Group], id)
+			b.acked = append(b.acked, id)
+		}
+		b.mu.Unlock()
+		send(&abi.CommandResponse{Status: 200})
+	case req.GetNack() != nil:
+		nack := req.GetNack()
+		key := nack.Topic + "/" + nack.Group
+		b.mu.Lock()
+		for _, id := range nack.Ids {
+			msg, ok := b.pending[key][id]
+			if !ok {
+				continue
+			}
+			delete(b.pending[key], id)
+			if g := b.groups[key]; g != nil && g.MaxDeliveries > 0 && msg.Delivery >= g.MaxDeliveries {
+				if g.DeadLetterTopic != "" {
+					b.publish(g.DeadLetterTopic, msg.Publisher, msg.Payload)
+				}
+				continue
+			}
+			b.deliverToGroup(nack.Group, msg)
+		}
+		b.mu.Unlock()
+		send(&abi.CommandResponse{Status: 200})
+	case req.GetPublish() != nil:
+		pub := req.GetPublish()
+		b.mu.Lock()
+		msg, delivered := b.publish(pub.Topic, req.Metadata[MetadataClientName], pub.Data)
+		b.mu.Unlock()
+		acks := 0
+		for deadline := time.Now().Add(pub.WaitTimeout.AsDuration()); acks < int(pub.WaitAcks) && time.Now().Before(deadline); {
+			time.Sleep(time.Millisecond)
+			b.mu.Lock()
+			acks = 0
+			for _, id := range b.acked {
+				if id == msg.Id {
+					acks++
+				}
+			}
+			b.mu.Unlock()
+		}
+		send(&abi.CommandResponse{Status: 200, Pairs: []*abi.Kvpair{
+			{Key: "id", Value: &abi.Value{Value: &abi.Value_Integer{Integer: int64(msg.Id)}}},
+			{Key: "offset", Value: &abi.Value{Value: &abi.Value_Integer{Integer: int64(msg.Offset)}}},
+			{Key: "delivered", Value: &abi.Value{Value: &abi.Value_Integer{Integer: int64(delivered)}}},
+			{Key: "acks", Value: &abi.Value{Value: &abi.Value_Integer{Integer: int64(acks)}}},
+		}})
+	}
+}
+
+func (b *broker) publish(topic, publisher string, payload []*abi.Value) (*abi.Message, int) {
+	b.ids[topic]++
+	msg := &abi.Message{
+		Topic:       topic,
+		Id:          b.ids[topic],
+		PublishedAt: timestamppb.Now(),
+		Publisher:   publisher,
+		Payload:     payload,
+	}
+	if max := b.retain[topic]; max > 0 {
+		msg.Offset = msg.Id
+		log := append(b.logs[topic], msg)
+		if uint64(len(log)) > max {
+			log = log[uint64(len(log))-max:]
+		}
+		b.logs[topic] = log
+	}
+	groups := make(map[string]bool)
+	delivered := 0
+	for _, sub := range b.subs {
+		if !sub.matches(topic) {
+			continue
+		}
+		if sub.group == "" {
+			sub.send(deliver(msg))
+			delivered++
+		} else if !groups[sub.group] {
+			groups[sub.group] = true
+			b.deliverToGroup(sub.group, proto.Clone(msg).(*abi.Message))
+			delivered++
+		}
+	}
+	return msg, delivered
+}
+
+// deliverToGroup sends msg to the members of group in turn.
+func (b *broker) deliverToGroup(group string, msg *abi.Message) {
+	var members []*subscription
+	for _, sub := range b.subs {
+		if sub.group == group && sub.matches(msg.Topic) {
+			members = append(members, sub)
+		}
+	}
+	if len(members) == 0 {
+		return
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].id < members[j].id })
+	key := msg.Topic + "/" + group
+	member := members[b.next[key]%len(members)]
+	b.next[key]++
+	msg.Delivery++
+	if b.pending[key] == nil {
+		b.pending[key] = make(map[uint64]*abi.Message)
+	}
+	b.pending[key][msg.Id] = msg
+	member.send(deliver(msg))
+}
+
+func deliver(msg *abi.Message) *abi.CommandResponse {
+	return &abi.CommandResponse{Status: 200, Topic: msg.Topic, Values: msg.Payload, Envelope: msg}
+}
+
+func (s *subscription) matches(topic string) bool {
+	if s.glob {
+		matched, _ := path.Match(s.pattern, topic)
+		return matched
+	}
+	return s.pattern == topic
+}
+
+func (b *broker) subscribe(pattern string, glob bool, req *abi.Subscribe, send func(*abi.CommandResponse)) {
+	b.mu.Lock()
+	b.nextId++
+	sub := &subscription{id: b.nextId, pattern: pattern, glob: glob, group: req.Group, send: send, done: make(chan struct{})}
+	b.subs[b.nextId] = sub
+	send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: b.nextId}}}})
+	if req.Start != abi.StartPosition_LATEST {
+		for _, msg := range b.logs[pattern] {
+			if req.Start == abi.StartPosition_EARLIEST || msg.Offset >= req.Offset {
+				send(deliver(msg))
+			}
+		}
+	}
+	b.mu.Unlock()
+	<-sub.done
+}
+
+func (b *broker) unsubscribe(id int64, send func(*abi.CommandResponse)) {
+	b.mu.Lock()
+	sub, ok := b.subs[id]
+	delete(b.subs, id)
+	if ok {
+		sub.send(&abi.CommandResponse{})
+		close(sub.done)
+	}
+	b.mu.Unlock()
+	if !ok {
+		send(&abi.CommandResponse{Status: 404})
+		return
+	}
+	send(&abi.CommandResponse{Status: 200})
+}
+
+func TestPsubscribe(t *testing.T) {
+	b := &broker{}
+	c := newTestClient(t, b.handle)
+	ctx := context.Background()
+	res, err := c.Psubscribe(ctx, "orders.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, topic := range []string{"orders.created", "users.created", "orders.paid"} {
+		if _, err = c.Publish(ctx, topic, &abi.Value{Value: &abi.Value_String_{String_: topic}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = c.Punsubscribe(ctx, "orders.*", res.Id()); err != nil {
+		t.Fatal(err)
+	}
+	var topics []string
+	for resp := range res.Chan() {
+		if resp.Values[0].GetString_() != resp.Topic {
+			t.Fatalf("message %v delivered as %s", resp.Values, resp.Topic)
+		}
+		topics = append(topics, resp.Topic)
+	}
+	if len(topics) != 2 || topics[0] != "orders.created" || topics[1] != "orders.paid" {
+		t.Fatalf("unexpected topics %v", topics)
+	}
+}
+
+func TestPsubscribeHeartbeat(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("psubscribe"))
+			return
+		}
+		if heartbeat := req.GetPsubscribe().GetHeartbeat(); heartbeat.AsDuration() != 10*time.Millisecond {
+			t.Errorf("heartbeat %v", heartbeat)
+		}
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		// the server went silent
+		<-done
+	})
+	ctx := context.Background()
+
+	res, err := c.Psubscribe(ctx, "orders.*", WithHeartbeat(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range res.Chan() {
+	}
+	if res.Err() != ErrHeartbeatMissed {
+		t.Fatalf("unexpected error %v", res.Err())
+	}
+
+	if _, err = c.Psubscribe(ctx, "orders.*", FromEarliest()); err != ErrPatternOption {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestMessages(t *testing.T) {
+	b := &broker{}
+	c := newTestClient(t, b.handle)
+	c.Use(MetadataInterceptor(map[string]string{MetadataClientName: "producer"}))
+	ctx := context.Background()
+	res, err := c.Subscribe(ctx, "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = c.Publish(ctx, "orders", &abi.Value{Value: &abi.Value_Integer{Integer: int64(i)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = c.Unsubscribe(ctx, "orders", res.Id()); err != nil {
+		t.Fatal(err)
+	}
+	var i int64
+	for msg := range res.Messages() {
+		if msg.Topic != "orders" || msg.Id != uint64(i+1) || msg.Publisher != "producer" || msg.PublishedAt == nil || msg.Payload[0].GetInteger() != i {
+			t.Fatalf("unexpected message %v", msg)
+		}
+		i++
+	}
+	if i != 2 {
+		t.Fatalf("got %d messages", i)
+	}
+}
+
+func TestSubscribeReplay(t *testing.T) {
+	b := &broker{}
+	c := newTestClient(t, b.handle)
+	ctx := context.Background()
+	if err := c.ConfigureTopic(ctx, "orders", Retention{MaxMessages: 3}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 5; i++ {
+		if _, err := c.Publish(ctx, "orders", &abi.Value{Value: &abi.Value_Integer{Integer: int64(i)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		opts    []SubscribeOption
+		offsets []uint64
+	}{
+		{nil, nil},
+		{[]SubscribeOption{FromEarliest()}, []uint64{3, 4, 5}},
+		{[]SubscribeOption{FromOffset(4)}, []uint64{4, 5}},
+		// older than anything retained
+		{[]SubscribeOption{FromOffset(1)}, []uint64{3, 4, 5}},
+	} {
+		res, err := c.Subscribe(ctx, "orders", tc.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = c.Unsubscribe(ctx, "orders", res.Id()); err != nil {
+			t.Fatal(err)
+		}
+		var offsets []uint64
+		for msg := range res.Messages() {
+			offsets = append(offsets, msg.Offset)
+		}
+		if !reflect.DeepEqual(offsets, tc.offsets) {
+			t.Fatalf("got offsets %v, want %v", offsets, tc.offsets)
+		}
+	}
+}
+
+func TestStreamEnd(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("subscribe"))
+			return
+		}
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		switch req.GetSubscribe().Topic {
+		case "kicked":
+			send(&abi.CommandResponse{Status: 200, Frame: abi.StreamFrame_HEARTBEAT})
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+			send(&abi.CommandResponse{Status: 200, Frame: abi.StreamFrame_HEARTBEAT})
+			send(&abi.CommandResponse{Frame: abi.StreamFrame_END, EndReason: abi.EndReason_KICKED, Message: "lagging"})
+		case "unsubscribed":
+			send(&abi.CommandResponse{Frame: abi.StreamFrame_END, EndReason: abi.EndReason_UNSUBSCRIBED})
+		case "silent":
+			<-done
+		}
+	})
+	ctx := context.Background()
+
+	res, err := c.Subscribe(ctx, "kicked", WithHeartbeat(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	for range res.Chan() {
+		n++
+	}
+	var end *StreamEndError
+	if n != 1 || !errors.As(res.Err(), &end) || end.Reason != abi.EndReason_KICKED || end.Message != "lagging" {
+		t.Fatalf("got %d responses, err %v", n, res.Err())
+	}
+
+	if res, err = c.Subscribe(ctx, "unsubscribed"); err != nil {
+		t.Fatal(err)
+	}
+	for range res.Chan() {
+	}
+	if res.Err() != nil {
+		t.Fatalf("unexpected error %v", res.Err())
+	}
+
+	if res, err = c.Subscribe(ctx, "silent", WithHeartbeat(10*time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	for range res.Chan() {
+	}
+	if res.Err() != ErrHeartbeatMissed {
+		t.Fatalf("unexpected error %v", res.Err())
+	}
+}
+
+func TestHeartbeatSlowConsumer(t *testing.T) {
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("subscribe"))
+			return
+		}
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		for i := 0; i < 20; i++ {
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: int64(i)}}}})
+			send(&abi.CommandResponse{Status: 200, Frame: abi.StreamFrame_HEARTBEAT})
+			time.Sleep(5 * time.Millisecond)
+		}
+		send(&abi.CommandResponse{Frame: abi.StreamFrame_END, EndReason: abi.EndReason_UNSUBSCRIBED})
+	})
+
+	res, err := c.Subscribe(context.Background(), "orders", WithHeartbeat(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	for range res.Chan() {
+		if n++; n == 1 {
+			// the channel fills up while the server keeps the stream alive
+			time.Sleep(200 * time.Millisecond)
+		}
+	}
+	if n != 20 || res.Err() != nil {
+		t.Fatalf("got %d messages, err %v", n, res.Err())
+	}
+}
+
+func TestStreamResultClose(t *testing.T) {
+	b := &broker{}
+	c := newTestClient(t, b.handle)
+	ctx := context.Background()
+	for _, messages := range []bool{false, true} {
+		res, err := c.Subscribe(ctx, "orders")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if messages {
+			res.Messages()
+		}
+		for i := 0; i < 3; i++ {
+			if _, err = c.Publish(ctx, "orders", &abi.Value{Value: &abi.Value_Integer{Integer: int64(i)}}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = res.Close(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := <-res.Chan(); ok {
+			t.Fatal("channel not drained")
+		}
+		b.mu.Lock()
+		subs := len(b.subs)
+		b.mu.Unlock()
+		if subs != 0 {
+			t.Fatalf("%d subscriptions left", subs)
+		}
+	}
+}
+
+func TestSubscribeFilter(t *testing.T) {
+	received := make(chan *abi.Filter, 1)
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("subscribe"))
+			return
+		}
+		received <- req.GetSubscribe().GetFilter()
+		send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		send(&abi.CommandResponse{})
+	})
+	str := func(s string) *abi.Value {
+		return &abi.Value{Value: &abi.Value_String_{String_: s}}
+	}
+	condition := func(path string, op abi.Op, value *abi.Value) *abi.Filter {
+		return &abi.Filter{Expr: &abi.Filter_Condition{Condition: &abi.Condition{Path: path, Op: op, Value: value}}}
+	}
+
+	ops := []abi.Op{abi.Op_EQ, abi.Op_NE, abi.Op_LT, abi.Op_LE, abi.Op_GT, abi.Op_GE, abi.Op_PREFIX, abi.Op_CONTAINS}
+	for _, op := range ops {
+		want := condition("0.tenant", op, str("acme"))
+		for _, tc := range []struct {
+			filter *abi.Filter
+			want   *abi.Filter
+		}{
+			{Where("0.tenant", op, str("acme")), want},
+			{Not(Where("0.tenant", op, str("acme"))), &abi.Filter{Expr: &abi.Filter_Not{Not: want}}},
+			{
+				All(Where("0.tenant", op, str("acme")), Any(Where("1", abi.Op_EQ, str("paid")), Where("1", abi.Op_EQ, str("shipped")))),
+				&abi.Filter{Expr: &abi.Filter_All{All: &abi.FilterList{Filters: []*abi.Filter{
+					want,
+					{Expr: &abi.Filter_Any{Any: &abi.FilterList{Filters: []*abi.Filter{
+						condition("1", abi.Op_EQ, str("paid")),
+						condition("1", abi.Op_EQ, str("shipped")),
+					}}}},
+				}}}},
+			},
+		} {
+			res, err := c.Subscribe(context.Background(), "orders", WithFilter(tc.filter))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for range res.Chan() {
+			}
+			if got := <-received; !proto.Equal(got, tc.want) {
+				t.Fatalf("sent filter %v, want %v", got, tc.want)
+			}
+		}
+	}
+
+	res, err := c.Subscribe(context.Background(), "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range res.Chan() {
+	}
+	if got := <-received; got != nil {
+		t.Fatalf("unexpected filter %v", got)
+	}
+}
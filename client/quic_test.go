@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	kvgo "github.com/caelansar/kv-go"
+	"github.com/caelansar/kv-go/client/multiplex"
+	abi "github.com/caelansar/kv-go/pb"
+	"github.com/lucas-clemente/quic-go"
+	"go.uber.org/zap"
+)
+
+// newQuicClient serves handler on a local QUIC listener, QuicStream.Close
+// differs from the in-memory pipes of fakeSession.
+func newQuicClient(t *testing.T, handler func(*abi.CommandRequest, func(*abi.CommandResponse))) *Client {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour), DNSNames: []string{"localhost"}}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := quic.ListenAddr("127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{cert}, PrivateKey: key}},
+		NextProtos:   []string{"h3"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept(context.Background())
+			if err != nil {
+				return
+			}
+			go func() {
+				for {
+					s, err := conn.AcceptStream(context.Background())
+					if err != nil {
+						return
+					}
+					go serve(s, handler, false, false)
+				}
+			}()
+		}
+	}()
+
+	session, err := multiplex.NewQuicSession(ln.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { session.(*multiplex.QuicSession).Close() })
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestQuicStreamUnblocks(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	c := newQuicClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		if req.GetHello() != nil {
+			send(helloResponse("hget", "subscribe"))
+			return
+		}
+		if req.GetSubscribe() != nil {
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+		}
+		// never answer
+		<-done
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	errc := make(chan error, 1)
+	go func() {
+		_, err := c.ExecuteContext(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_Hget{Hget: &abi.Hget{Table: "t", Key: "k"}}})
+		errc <- err
+	}()
+	select {
+	case err := <-errc:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("unexpected error %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cancelled request still blocked")
+	}
+
+	res, err := c.Subscribe(context.Background(), "silent", WithHeartbeat(20*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-drainResult(res):
+		if res.Err() != ErrHeartbeatMissed {
+			t.Fatalf("unexpected error %v", res.Err())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("missed heartbeat not detected")
+	}
+}
+
+func drainResult(res *StreamResult) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		for range res.Chan() {
+		}
+		close(done)
+	}()
+	return done
+}
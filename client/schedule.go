@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduledMessage is a message held by the server until DeliverAt.
+type ScheduledMessage struct {
+	Id        uint64
+	Topic     string
+	DeliverAt time.Time
+	Payload   []*abi.Value
+}
+
+// PublishAt schedules data to be published on topic at t. The receipt Id
+// identifies the schedule, e.g. for CancelScheduled.
+func (c *Client) PublishAt(ctx context.Context, topic string, t time.Time, data ...*abi.Value) (*PublishReceipt, error) {
+	return c.publish(ctx, &abi.Publish{Topic: topic, Data: data, DeliverAt: timestamppb.New(t)})
+}
+
+// PublishAfter schedules data to be published on topic once delay passed on
+// the server clock.
+func (c *Client) PublishAfter(ctx context.Context, topic string, delay time.Duration, data ...*abi.Value) (*PublishReceipt, error) {
+	return c.publish(ctx, &abi.Publish{Topic: topic, Data: data, Delay: durationpb.New(delay)})
+}
+
+// ListScheduled returns the messages scheduled on topic, or on every topic if
+// empty, soonest first.
+func (c *Client) ListScheduled(ctx context.Context, topic string) ([]*ScheduledMessage, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_ListScheduled{
+		ListScheduled: &abi.ListScheduled{Topic: topic},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	scheduled := make([]*ScheduledMessage, 0, len(resp.Values))
+	for _, v := range resp.Values {
+		m := v.GetMap()
+		if m == nil {
+			return nil, fmt.Errorf("kv: expected scheduled message map, got %T", v.GetValue())
+		}
+		msg := &ScheduledMessage{
+			Id:      uint64(m.Values["id"].GetInteger()),
+			Topic:   m.Values["topic"].GetString_(),
+			Payload: m.Values["payload"].GetList().GetValues(),
+		}
+		if ts := m.Values["deliver_at"].GetTimestamp(); ts != nil {
+			msg.DeliverAt = ts.AsTime()
+		}
+		scheduled = append(scheduled, msg)
+	}
+	return scheduled, nil
+}
+
+// CancelScheduled drops the scheduled message id, it fails with ErrNotFound
+// once the message was released.
+func (c *Client) CancelScheduled(ctx context.Context, topic string, id uint64) error {
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_CancelScheduled{
+		CancelScheduled: &abi.CancelScheduled{Topic: topic, Id: id},
+	}})
+	return err
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// scheduler holds scheduled messages without ever releasing them.
+type scheduler struct {
+	nextId    int64
+	scheduled map[int64]map[string]interface{}
+}
+
+func (s *scheduler) handle(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+	switch {
+	case req.GetHello() != nil:
+		send(helloResponse("publish", "list_scheduled", "cancel_scheduled"))
+	case req.GetPublish() != nil:
+		pub := req.GetPublish()
+		at := pub.DeliverAt.AsTime()
+		if pub.Delay != nil {
+			at = time.Now().Add(pub.Delay.AsDuration())
+		}
+		s.nextId++
+		s.scheduled[s.nextId] = map[string]interface{}{"id": s.nextId, "topic": pub.Topic, "deliver_at": at, "payload": pub.Data}
+		send(&abi.CommandResponse{Status: 200, Pairs: []*abi.Kvpair{
+			{Key: "id", Value: &abi.Value{Value: &abi.Value_Integer{Integer: s.nextId}}},
+		}})
+	case req.GetListScheduled() != nil:
+		resp := &abi.CommandResponse{Status: 200}
+		for _, msg := range s.scheduled {
+			v, err := abi.ValueOf(msg)
+			if err != nil {
+				send(&abi.CommandResponse{Status: 500, Message: err.Error()})
+				return
+			}
+			resp.Values = append(resp.Values, v)
+		}
+		sort.Slice(resp.Values, func(i, j int) bool {
+			return resp.Values[i].GetMap().Values["deliver_at"].GetTimestamp().AsTime().Before(resp.Values[j].GetMap().Values["deliver_at"].GetTimestamp().AsTime())
+		})
+		send(resp)
+	case req.GetCancelScheduled() != nil:
+		id := int64(req.GetCancelScheduled().Id)
+		if _, ok := s.scheduled[id]; !ok {
+			send(&abi.CommandResponse{Status: 404})
+			return
+		}
+		delete(s.scheduled, id)
+		send(&abi.CommandResponse{Status: 200})
+	}
+}
+
+func TestScheduledPublish(t *testing.T) {
+	s := &scheduler{scheduled: make(map[int64]map[string]interface{})}
+	c := newTestClient(t, s.handle)
+	ctx := context.Background()
+	payload := &abi.Value{Value: &abi.Value_String_{String_: "reminder"}}
+
+	at := time.Now().Add(time.Hour).UTC()
+	first, err := c.PublishAt(ctx, "jobs", at, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.PublishAfter(ctx, "jobs", time.Minute, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheduled, err := c.ListScheduled(ctx, "jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scheduled) != 2 || scheduled[0].Id != second.Id || scheduled[1].Id != first.Id {
+		t.Fatalf("unexpected schedule %+v", scheduled)
+	}
+	if !scheduled[1].DeliverAt.Equal(at) || scheduled[1].Topic != "jobs" || scheduled[1].Payload[0].GetString_() != "reminder" {
+		t.Fatalf("unexpected scheduled message %+v", scheduled[1])
+	}
+
+	if err = c.CancelScheduled(ctx, "jobs", first.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.CancelScheduled(ctx, "jobs", first.Id); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if scheduled, err = c.ListScheduled(ctx, "jobs"); err != nil || len(scheduled) != 1 {
+		t.Fatalf("unexpected schedule %v %v", scheduled, err)
+	}
+}
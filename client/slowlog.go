@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// SlowlogEntry is a command that exceeded the server latency threshold.
+type SlowlogEntry struct {
+	Id       int64
+	Time     time.Time
+	Command  string
+	Table    string
+	Client   string
+	Keys     int64
+	Duration time.Duration
+}
+
+// SlowlogGet returns the latest count entries of the slow log, newest first,
+// or all of them if count is 0.
+func (c *Client) SlowlogGet(ctx context.Context, count uint32) ([]*SlowlogEntry, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_SlowlogGet{
+		SlowlogGet: &abi.SlowlogGet{Count: count},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*SlowlogEntry, 0, len(resp.Values))
+	for _, v := range resp.Values {
+		m := v.GetMap()
+		if m == nil {
+			return nil, fmt.Errorf("kv: expected slowlog entry map, got %T", v.GetValue())
+		}
+		e := &SlowlogEntry{
+			Id:      m.Values["id"].GetInteger(),
+			Command: m.Values["command"].GetString_(),
+			Table:   m.Values["table"].GetString_(),
+			Client:  m.Values["client"].GetString_(),
+			Keys:    m.Values["keys"].GetInteger(),
+		}
+		if ts := m.Values["time"].GetTimestamp(); ts != nil {
+			e.Time = ts.AsTime()
+		}
+		if d := m.Values["duration"].GetDuration(); d != nil {
+			e.Duration = d.AsDuration()
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (c *Client) SlowlogReset(ctx context.Context) error {
+	_, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_SlowlogReset{
+		SlowlogReset: &abi.SlowlogReset{},
+	}})
+	return err
+}
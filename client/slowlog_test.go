@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+func TestSlowlog(t *testing.T) {
+	var entries []*abi.Value
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		switch {
+		case req.GetHello() != nil:
+			send(helloResponse("slowlog_get", "slowlog_reset"))
+		case req.GetSlowlogReset() != nil:
+			entries = nil
+			send(&abi.CommandResponse{Status: 200})
+		default:
+			send(&abi.CommandResponse{Status: 200, Values: entries})
+		}
+	})
+	entry, err := abi.ValueOf(map[string]interface{}{
+		"id":       1,
+		"command":  "hgetall",
+		"table":    "t1",
+		"client":   "c1",
+		"keys":     1000,
+		"duration": 30 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries = []*abi.Value{entry}
+
+	got, err := c.SlowlogGet(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := SlowlogEntry{Id: 1, Command: "hgetall", Table: "t1", Client: "c1", Keys: 1000, Duration: 30 * time.Millisecond}
+	if len(got) != 1 || *got[0] != want {
+		t.Fatalf("unexpected entries %+v", got)
+	}
+	if err = c.SlowlogReset(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = c.SlowlogGet(context.Background(), 0); err != nil || len(got) != 0 {
+		t.Fatalf("unexpected entries after reset %v %v", got, err)
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	kvgo "github.com/caelansar/kv-go"
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// stream carries the frames of one command. A frame failing its checksum
+// poisons it: the framing of whatever follows can't be trusted, so the stream
+// is closed and every later send or recv fails with the same error.
+type stream struct {
+	io.ReadWriteCloser
+	codec kvgo.Codec
+	err   error
+	// heartbeat interval asked for by a streaming command, 0 if none
+	heartbeat time.Duration
+}
+
+// MISSED_HEARTBEATS is the number of heartbeat intervals without any frame
+// after which a stream is considered dead.
+const MISSED_HEARTBEATS = 3
+
+var ErrHeartbeatMissed = errors.New("kv: stream heartbeat missed")
+
+// StreamEndError reports a stream ended by the server for another reason
+// than the client unsubscribing, see StreamResult.Err.
+type StreamEndError struct {
+	Reason  abi.EndReason
+	Message string
+}
+
+func (e *StreamEndError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("kv: stream ended: %s", e.Reason)
+	}
+	return fmt.Sprintf("kv: stream ended: %s: %s", e.Reason, e.Message)
+}
+
+// endError returns the error of an END frame, nil when unsubscribed.
+func endError(resp *abi.CommandResponse) error {
+	if resp.EndReason == abi.EndReason_UNSUBSCRIBED {
+		return nil
+	}
+	return &StreamEndError{Reason: resp.EndReason, Message: resp.Message}
+}
+
+func (c *Client) open() (*stream, error) {
+	s, err := c.session.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &stream{ReadWriteCloser: s, codec: c.currentCodec()}, nil
+}
+
+func (s *stream) send(req *abi.CommandRequest) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.codec.Encode(s, req)
+}
+
+func (s *stream) recv() (*abi.CommandResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	resp, err := s.codec.Decode(s)
+	if errors.Is(err, kvgo.ErrCorruptFrame) || errors.Is(err, kvgo.ErrFrameTooLarge) {
+		s.err = err
+		s.Close()
+	}
+	return resp, err
+}
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+const tagName = "kv"
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+type field struct {
+	name      string
+	index     int
+	omitEmpty bool
+}
+
+var fieldCache sync.Map // map[reflect.Type][]field
+
+// fields returns the mapped fields of struct type t, honouring `kv:"name,omitempty"`
+// tags. Unexported fields and fields tagged "-" are skipped.
+func fields(t reflect.Type) []field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field)
+	}
+	var fs []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		tag := sf.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		fs = append(fs, field{name: name, index: i, omitEmpty: opts == "omitempty"})
+	}
+	f, _ := fieldCache.LoadOrStore(t, fs)
+	return f.([]field)
+}
+
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, errors.New("kv: nil struct pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("kv: expected struct, got %T", v)
+	}
+	return rv, nil
+}
+
+// Marshal converts the exported fields of a struct into one Kvpair per field.
+func Marshal(v interface{}) ([]*abi.Kvpair, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	fs := fields(rv.Type())
+	pairs := make([]*abi.Kvpair, 0, len(fs))
+	for _, f := range fs {
+		fv := rv.Field(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		value, err := abi.ValueOf(fv.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("kv: field %s: %w", f.name, err)
+		}
+		pairs = append(pairs, &abi.Kvpair{Key: f.name, Value: value})
+	}
+	return pairs, nil
+}
+
+// Unmarshal stores pairs into the struct pointed to by v. Pairs without a
+// matching field are ignored.
+func Unmarshal(pairs []*abi.Kvpair, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("kv: Unmarshal requires a non-nil pointer, got %T", v)
+	}
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]int)
+	for _, f := range fields(rv.Type()) {
+		byName[f.name] = f.index
+	}
+	for _, pair := range pairs {
+		i, ok := byName[pair.GetKey()]
+		if !ok {
+			continue
+		}
+		if err := setValue(rv.Field(i), pair.GetValue()); err != nil {
+			return fmt.Errorf("kv: field %s: %w", pair.GetKey(), err)
+		}
+	}
+	return nil
+}
+
+func setValue(fv reflect.Value, value *abi.Value) error {
+	if value.IsNull() {
+		fv.Set(reflect.Zero(fv.Type()))
+		return nil
+	}
+
+	switch fv.Type() {
+	case timeType:
+		switch x := value.GetValue().(type) {
+		case *abi.Value_Timestamp:
+			fv.Set(reflect.ValueOf(x.Timestamp.AsTime()))
+		case *abi.Value_String_:
+			t, err := time.Parse(time.RFC3339Nano, x.String_)
+			if err != nil {
+				return err
+			}
+			fv.Set(reflect.ValueOf(t))
+		case *abi.Value_Integer:
+			fv.Set(reflect.ValueOf(time.Unix(0, x.Integer).UTC()))
+		default:
+			return mismatch(fv, value)
+		}
+		return nil
+	case durationType:
+		switch x := value.GetValue().(type) {
+		case *abi.Value_Duration:
+			fv.SetInt(int64(x.Duration.AsDuration()))
+		case *abi.Value_Integer:
+			fv.SetInt(x.Integer)
+		case *abi.Value_String_:
+			d, err := time.ParseDuration(x.String_)
+			if err != nil {
+				return err
+			}
+			fv.SetInt(int64(d))
+		default:
+			return mismatch(fv, value)
+		}
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(fv.Type().Elem())
+		if err := setValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		fv.Set(elem)
+	case reflect.String:
+		switch x := value.GetValue().(type) {
+		case *abi.Value_String_:
+			fv.SetString(x.String_)
+		case *abi.Value_Binary:
+			fv.SetString(string(x.Binary))
+		default:
+			return mismatch(fv, value)
+		}
+	case reflect.Bool:
+		x, ok := value.GetValue().(*abi.Value_Bool)
+		if !ok {
+			return mismatch(fv, value)
+		}
+		fv.SetBool(x.Bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, ok := value.GetValue().(*abi.Value_Integer)
+		if !ok {
+			return mismatch(fv, value)
+		}
+		if fv.OverflowInt(x.Integer) {
+			return fmt.Errorf("%d overflows %s", x.Integer, fv.Type())
+		}
+		fv.SetInt(x.Integer)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		x, ok := value.GetValue().(*abi.Value_Integer)
+		if !ok {
+			return mismatch(fv, value)
+		}
+		if x.Integer < 0 || fv.OverflowUint(uint64(x.Integer)) {
+			return fmt.Errorf("%d overflows %s", x.Integer, fv.Type())
+		}
+		fv.SetUint(uint64(x.Integer))
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch x := value.GetValue().(type) {
+		case *abi.Value_Float:
+			f = x.Float
+		case *abi.Value_Integer:
+			f = float64(x.Integer)
+		default:
+			return mismatch(fv, value)
+		}
+		if fv.Kind() == reflect.Float32 && math.Abs(f) > math.MaxFloat32 && !math.IsInf(f, 0) {
+			return fmt.Errorf("%g overflows %s", f, fv.Type())
+		}
+		fv.SetFloat(f)
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() == reflect.Uint8 {
+			switch x := value.GetValue().(type) {
+			case *abi.Value_Binary:
+				fv.SetBytes(x.Binary)
+			case *abi.Value_String_:
+				fv.SetBytes([]byte(x.String_))
+			default:
+				return mismatch(fv, value)
+			}
+			return nil
+		}
+		x, ok := value.GetValue().(*abi.Value_List)
+		if !ok {
+			return mismatch(fv, value)
+		}
+		values := x.List.GetValues()
+		s := reflect.MakeSlice(fv.Type(), len(values), len(values))
+		for i, v := range values {
+			if err := setValue(s.Index(i), v); err != nil {
+				return err
+			}
+		}
+		fv.Set(s)
+	case reflect.Map:
+		x, ok := value.GetValue().(*abi.Value_Map)
+		if !ok || fv.Type().Key().Kind() != reflect.String {
+			return mismatch(fv, value)
+		}
+		m := reflect.MakeMapWithSize(fv.Type(), len(x.Map.GetValues()))
+		for k, v := range x.Map.GetValues() {
+			elem := reflect.New(fv.Type().Elem()).Elem()
+			if err := setValue(elem, v); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(k).Convert(fv.Type().Key()), elem)
+		}
+		fv.Set(m)
+	case reflect.Interface:
+		v := reflect.ValueOf(value.Interface())
+		if !v.Type().AssignableTo(fv.Type()) {
+			return mismatch(fv, value)
+		}
+		fv.Set(v)
+	default:
+		return mismatch(fv, value)
+	}
+	return nil
+}
+
+func mismatch(fv reflect.Value, value *abi.Value) error {
+	return fmt.Errorf("cannot store %T into %s", value.GetValue(), fv.Type())
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+type user struct {
+	Name     string        `kv:"name"`
+	Age      int           `kv:"age"`
+	Score    float64       `kv:"score,omitempty"`
+	Admin    bool          `kv:"admin"`
+	Avatar   []byte        `kv:"avatar,omitempty"`
+	Created  time.Time     `kv:"created"`
+	TTL      time.Duration `kv:"ttl"`
+	Nickname *string       `kv:"nickname"`
+	Internal string        `kv:"-"`
+	Untagged uint8
+	secret   string
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	nick := "al"
+	in := user{
+		Name:     "alice",
+		Age:      30,
+		Admin:    true,
+		Created:  time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC),
+		TTL:      time.Minute,
+		Nickname: &nick,
+		Internal: "skip",
+		Untagged: 7,
+		secret:   "skip",
+	}
+	pairs, err := Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	for _, p := range pairs {
+		keys = append(keys, p.Key)
+	}
+	want := []string{"name", "age", "admin", "created", "ttl", "nickname", "Untagged"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Fatalf("keys: got %v, want %v", keys, want)
+	}
+
+	var out user
+	if err = Unmarshal(pairs, &out); err != nil {
+		t.Fatal(err)
+	}
+	in.Internal, in.secret = "", ""
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("got %+v, want %+v", out, in)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var u user
+	if err := Unmarshal(nil, u); err == nil {
+		t.Fatal("expected error for non-pointer")
+	}
+
+	pairs := []*abi.Kvpair{{Key: "age", Value: &abi.Value{Value: &abi.Value_String_{String_: "x"}}}}
+	if err := Unmarshal(pairs, &u); err == nil {
+		t.Fatal("expected type mismatch")
+	}
+
+	pairs = []*abi.Kvpair{{Key: "Untagged", Value: &abi.Value{Value: &abi.Value_Integer{Integer: 256}}}}
+	if err := Unmarshal(pairs, &u); err == nil {
+		t.Fatal("expected overflow")
+	}
+}
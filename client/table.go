@@ -0,0 +1,154 @@
+package client
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// ValueCodec serializes table values into the binary payload of an abi.Value.
+type ValueCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	JSON  ValueCodec = jsonCodec{}
+	Gob   ValueCodec = gobCodec{}
+	Proto ValueCodec = protoCodec{}
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+type gobCodec struct{}
+
+func (gobCodec) Marshal(v interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(v); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// protoCodec requires T to be a pointer to a generated message, e.g. Table[*pb.User].
+type protoCodec struct{}
+
+func (protoCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("kv: %T is not a proto.Message", v)
+	}
+	return proto.Marshal(m)
+}
+
+func (protoCodec) Unmarshal(data []byte, v interface{}) error {
+	// v is a *T where T is itself a message pointer, allocate the message
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Ptr {
+		if rv.Elem().IsNil() {
+			rv.Elem().Set(reflect.New(rv.Elem().Type().Elem()))
+		}
+		v = rv.Elem().Interface()
+	}
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("kv: %T is not a proto.Message", v)
+	}
+	return proto.Unmarshal(data, m)
+}
+
+// TableHandle is a typed view over one table, created by Table.
+type TableHandle[T any] struct {
+	client *Client
+	name   string
+	codec  ValueCodec
+}
+
+// Table returns a handle that stores values of type T in table name, serialized
+// with codec into abi.Value_Binary.
+func Table[T any](c *Client, name string, codec ValueCodec) *TableHandle[T] {
+	return &TableHandle[T]{
+		client: c,
+		name:   name,
+		codec:  codec,
+	}
+}
+
+func (t *TableHandle[T]) Name() string {
+	return t.name
+}
+
+func (t *TableHandle[T]) Get(key string) (T, error) {
+	var v T
+	value, err := t.client.Hget(t.name, key)
+	if err != nil {
+		return v, err
+	}
+	return t.decode(value)
+}
+
+func (t *TableHandle[T]) Set(key string, v T) error {
+	data, err := t.codec.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return t.client.Hset(t.name, key, &abi.Value{Value: &abi.Value_Binary{Binary: data}})
+}
+
+func (t *TableHandle[T]) Delete(key string) error {
+	return t.client.Hdel(t.name, key)
+}
+
+func (t *TableHandle[T]) Exists(key string) (bool, error) {
+	return t.client.Hexist(t.name, key)
+}
+
+func (t *TableHandle[T]) All() (map[string]T, error) {
+	pairs, err := t.client.Hgetall(t.name)
+	if err != nil {
+		return nil, err
+	}
+	all := make(map[string]T, len(pairs))
+	for _, pair := range pairs {
+		v, err := t.decode(pair.Value)
+		if err != nil {
+			return nil, fmt.Errorf("kv: key %s: %w", pair.Key, err)
+		}
+		all[pair.Key] = v
+	}
+	return all, nil
+}
+
+func (t *TableHandle[T]) decode(value *abi.Value) (T, error) {
+	var v T
+	var data []byte
+	switch x := value.GetValue().(type) {
+	case *abi.Value_Binary:
+		data = x.Binary
+	case *abi.Value_String_:
+		data = []byte(x.String_)
+	default:
+		return v, fmt.Errorf("kv: expected binary value, got %T", value.GetValue())
+	}
+	if err := t.codec.Unmarshal(data, &v); err != nil {
+		return v, err
+	}
+	return v, nil
+}
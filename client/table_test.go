@@ -0,0 +1,73 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+type profile struct {
+	Name  string
+	Tags  []string
+	Since time.Time
+}
+
+func TestTable(t *testing.T) {
+	c := newTestClient(t, (&memStore{}).handle)
+
+	for name, codec := range map[string]ValueCodec{"json": JSON, "gob": Gob} {
+		t.Run(name, func(t *testing.T) {
+			users := Table[profile](c, "users_"+name, codec)
+			alice := profile{Name: "alice", Tags: []string{"a"}, Since: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+			if err := users.Set("alice", alice); err != nil {
+				t.Fatal(err)
+			}
+			got, err := users.Get("alice")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, alice) {
+				t.Fatalf("got %+v, want %+v", got, alice)
+			}
+
+			all, err := users.All()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(all, map[string]profile{"alice": alice}) {
+				t.Fatalf("unexpected All: %+v", all)
+			}
+
+			if err = users.Delete("alice"); err != nil {
+				t.Fatal(err)
+			}
+			if ok, err := users.Exists("alice"); err != nil || ok {
+				t.Fatalf("exists after delete: %v %v", ok, err)
+			}
+			if _, err = users.Get("alice"); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("expected ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestProtoTable(t *testing.T) {
+	c := newTestClient(t, (&memStore{}).handle)
+	pairs := Table[*abi.Kvpair](c, "pairs", Proto)
+
+	want := &abi.Kvpair{Key: "k", Value: &abi.Value{Value: &abi.Value_Integer{Integer: 1}}}
+	if err := pairs.Set("k", want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := pairs.Get("k")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetValue().GetInteger() != 1 || got.GetKey() != "k" {
+		t.Fatalf("got %v", got)
+	}
+}
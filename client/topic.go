@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// TopicInfo holds the statistics of a topic, see Client.TopicInfo.
+type TopicInfo struct {
+	Subscribers      int64
+	RetainedMessages int64
+	RetainedBytes    int64
+	// PublishRate in messages per second over the last minute.
+	PublishRate float64
+}
+
+// SubscriptionInfo describes a subscription, see Client.ListSubscriptions.
+type SubscriptionInfo struct {
+	Id     uint32
+	Topic  string
+	Client string
+	Group  string
+	// Lag is the number of messages published but not yet delivered.
+	Lag int64
+}
+
+// ListTopics returns the sorted names of the topics matching the glob
+// pattern, all of them if empty.
+func (c *Client) ListTopics(ctx context.Context, pattern string) ([]string, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_ListTopics{
+		ListTopics: &abi.ListTopics{Pattern: pattern},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]string, len(resp.Values))
+	for i, v := range resp.Values {
+		topics[i] = v.GetString_()
+	}
+	return topics, nil
+}
+
+// TopicInfo fails with ErrNotFound if topic doesn't exist.
+func (c *Client) TopicInfo(ctx context.Context, topic string) (*TopicInfo, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_TopicInfo{
+		TopicInfo: &abi.TopicInfo{Topic: topic},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	info := &TopicInfo{}
+	for _, pair := range resp.Pairs {
+		switch pair.Key {
+		case "subscribers":
+			info.Subscribers = pair.Value.GetInteger()
+		case "retained_messages":
+			info.RetainedMessages = pair.Value.GetInteger()
+		case "retained_bytes":
+			info.RetainedBytes = pair.Value.GetInteger()
+		case "publish_rate":
+			info.PublishRate = pair.Value.GetFloat()
+		}
+	}
+	return info, nil
+}
+
+// ListSubscriptions returns the subscriptions to topic, or to every topic if
+// empty.
+func (c *Client) ListSubscriptions(ctx context.Context, topic string) ([]*SubscriptionInfo, error) {
+	resp, err := c.call(ctx, &abi.CommandRequest{RequestData: &abi.CommandRequest_ListSubscriptions{
+		ListSubscriptions: &abi.ListSubscriptions{Topic: topic},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	subs := make([]*SubscriptionInfo, 0, len(resp.Values))
+	for _, v := range resp.Values {
+		m := v.GetMap()
+		if m == nil {
+			return nil, fmt.Errorf("kv: expected subscription map, got %T", v.GetValue())
+		}
+		subs = append(subs, &SubscriptionInfo{
+			Id:     uint32(m.Values["id"].GetInteger()),
+			Topic:  m.Values["topic"].GetString_(),
+			Client: m.Values["client"].GetString_(),
+			Group:  m.Values["group"].GetString_(),
+			Lag:    m.Values["lag"].GetInteger(),
+		})
+	}
+	return subs, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+func TestTopicIntrospection(t *testing.T) {
+	c := newTestClient(t, func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+		switch {
+		case req.GetHello() != nil:
+			send(helloResponse("list_topics", "topic_info", "list_subscriptions"))
+		case req.GetListTopics() != nil:
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{
+				{Value: &abi.Value_String_{String_: "orders.created"}},
+				{Value: &abi.Value_String_{String_: "orders.paid"}},
+			}})
+		case req.GetTopicInfo().GetTopic() == "orders.paid":
+			send(&abi.CommandResponse{Status: 200, Pairs: []*abi.Kvpair{
+				{Key: "subscribers", Value: &abi.Value{Value: &abi.Value_Integer{Integer: 2}}},
+				{Key: "retained_messages", Value: &abi.Value{Value: &abi.Value_Integer{Integer: 10}}},
+				{Key: "retained_bytes", Value: &abi.Value{Value: &abi.Value_Integer{Integer: 1024}}},
+				{Key: "publish_rate", Value: &abi.Value{Value: &abi.Value_Float{Float: 1.5}}},
+			}})
+		case req.GetTopicInfo() != nil:
+			send(&abi.CommandResponse{Status: 404})
+		case req.GetListSubscriptions() != nil:
+			sub, _ := abi.ValueOf(map[string]interface{}{"id": 7, "topic": "orders.paid", "client": "c1", "group": "billing", "lag": 3})
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{sub}})
+		}
+	})
+	ctx := context.Background()
+
+	topics, err := c.ListTopics(ctx, "orders.*")
+	if err != nil || !reflect.DeepEqual(topics, []string{"orders.created", "orders.paid"}) {
+		t.Fatalf("unexpected topics %v %v", topics, err)
+	}
+	info, err := c.TopicInfo(ctx, "orders.paid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (TopicInfo{Subscribers: 2, RetainedMessages: 10, RetainedBytes: 1024, PublishRate: 1.5}); *info != want {
+		t.Fatalf("got %+v, want %+v", info, want)
+	}
+	if _, err = c.TopicInfo(ctx, "unknown"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	subs, err := c.ListSubscriptions(ctx, "orders.paid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (SubscriptionInfo{Id: 7, Topic: "orders.paid", Client: "c1", Group: "billing", Lag: 3}); len(subs) != 1 || *subs[0] != want {
+		t.Fatalf("unexpected subscriptions %+v", subs)
+	}
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	abi "github.com/caelansar/kv-go/pb"
+)
+
+// WatchEvent is a change of a watched key. Old and New are null when the key
+// didn't exist before or was deleted.
+type WatchEvent struct {
+	Key     string
+	Old     *abi.Value
+	New     *abi.Value
+	Version uint64
+}
+
+func parseWatchEvent(resp *abi.CommandResponse) *WatchEvent {
+	e := &WatchEvent{}
+	for _, pair := range resp.Pairs {
+		switch pair.Key {
+		case "key":
+			e.Key = pair.Value.GetString_()
+		case "old":
+			e.Old = pair.Value
+		case "new":
+			e.New = pair.Value
+		case "version":
+			e.Version = uint64(pair.Value.GetInteger())
+		}
+	}
+	return e
+}
+
+// Backoff between attempts to resume a broken watch, doubling up to
+// WATCH_MAX_BACKOFF and reset once an event was received.
+const WATCH_MIN_BACKOFF = 50 * time.Millisecond
+const WATCH_MAX_BACKOFF = 5 * time.Second
+
+// Watch streams the changes of keys in table until ctx is done or the server
+// ends the watch. When the stream breaks, the watch alone is reopened, with
+// backoff, and resumes after the last version received, so no change is
+// missed as long as the server still remembers it. Reconnecting a dead
+// session is left to Reconnect or a HealthProber. The watch request, and each
+// one resuming it, goes through the stream interceptors. The channel is closed
+// when the server refuses to resume the watch.
+func (c *Client) Watch(ctx context.Context, table string, keys ...string) (<-chan *WatchEvent, error) {
+	watch := func(since uint64) *abi.CommandRequest {
+		return &abi.CommandRequest{RequestData: &abi.CommandRequest_Watch{
+			Watch: &abi.Watch{Table: table, Keys: keys, SinceVersion: since},
+		}}
+	}
+	res, err := c.ExecuteStreamingContext(ctx, watch(0))
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *WatchEvent, 10)
+	go func() {
+		defer close(ch)
+		var since uint64
+		backoff := WATCH_MIN_BACKOFF
+		for {
+			for resp := range res.Chan() {
+				e := parseWatchEvent(resp)
+				select {
+				case ch <- e:
+					since = e.Version
+					backoff = WATCH_MIN_BACKOFF
+				case <-ctx.Done():
+					return
+				}
+			}
+			err := res.Err()
+			var end *StreamEndError
+			if err == nil || ctx.Err() != nil || errors.As(err, &end) && end.Reason != abi.EndReason_SERVER_SHUTDOWN {
+				return
+			}
+			c.logger.Warnw("watch broken, resuming", "table", table, "since", since, "err", err)
+			for {
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return
+				}
+				if backoff *= 2; backoff > WATCH_MAX_BACKOFF {
+					backoff = WATCH_MAX_BACKOFF
+				}
+				if res, err = c.ExecuteStreamingContext(ctx, watch(since)); err == nil {
+					break
+				}
+				var status *StatusError
+				if errors.As(err, &status) || errors.Is(err, ErrUnsupportedCommand) || ctx.Err() != nil {
+					c.logger.Errorw("failed to resume watch", "table", table, "err", err)
+					return
+				}
+				c.logger.Warnw("failed to resume watch, retrying", "table", table, "backoff", backoff, "err", err)
+			}
+		}
+	}()
+	return ch, nil
+}
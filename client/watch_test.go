@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	kvgo "github.com/caelansar/kv-go"
+	"github.com/caelansar/kv-go/client/multiplex"
+	abi "github.com/caelansar/kv-go/pb"
+	"go.uber.org/zap"
+)
+
+func watchEvent(key string, version int64) *abi.CommandResponse {
+	return &abi.CommandResponse{Status: 200, Pairs: []*abi.Kvpair{
+		{Key: "key", Value: &abi.Value{Value: &abi.Value_String_{String_: key}}},
+		{Key: "old", Value: &abi.Value{Value: &abi.Value_Null{}}},
+		{Key: "new", Value: &abi.Value{Value: &abi.Value_Integer{Integer: version}}},
+		{Key: "version", Value: &abi.Value{Value: &abi.Value_Integer{Integer: version}}},
+	}}
+}
+
+func TestWatchResumes(t *testing.T) {
+	var dials, watches int32
+	streams := &openStreams{}
+	session, err := multiplex.NewReconnectSession(func() (multiplex.Session, error) {
+		atomic.AddInt32(&dials, 1)
+		streams.Session = &fakeSession{handler: func(req *abi.CommandRequest, send func(*abi.CommandResponse)) {
+			if req.GetHello() != nil {
+				send(helloResponse("watch"))
+				return
+			}
+			if req.Metadata[MetadataAuthToken] != "secret" {
+				t.Errorf("watch sent without the interceptor metadata: %v", req.Metadata)
+			}
+			n := atomic.AddInt32(&watches, 1)
+			if n == 2 {
+				// the first attempt to resume fails
+				return
+			}
+			send(&abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_Integer{Integer: 1}}}})
+			if n == 1 {
+				// break the stream after the first change
+				send(watchEvent("k1", 1))
+				return
+			}
+			if since := req.GetWatch().SinceVersion; since != 1 {
+				t.Errorf("resumed from version %d", since)
+			}
+			send(watchEvent("k1", 2))
+			send(&abi.CommandResponse{})
+		}}
+		return streams, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var intercepted int32
+	c.UseStream(func(ctx context.Context, req *abi.CommandRequest, next StreamInvoker) (*StreamResult, error) {
+		atomic.AddInt32(&intercepted, 1)
+		return next(ctx, req)
+	}, MetadataStreamInterceptor(map[string]string{MetadataAuthToken: "secret"}))
+
+	ch, err := c.Watch(context.Background(), "t1", "k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var versions []uint64
+	for e := range ch {
+		if e.Key != "k1" || !e.Old.IsNull() {
+			t.Fatalf("unexpected event %+v", e)
+		}
+		versions = append(versions, e.Version)
+	}
+	if len(versions) != 2 || versions[0] != 1 || versions[1] != 2 {
+		t.Fatalf("unexpected versions %v", versions)
+	}
+	// only the watch stream was reopened, not the session
+	if atomic.LoadInt32(&dials) != 1 || atomic.LoadInt32(&watches) != 3 {
+		t.Fatalf("%d dials, %d watches", atomic.LoadInt32(&dials), atomic.LoadInt32(&watches))
+	}
+	if atomic.LoadInt32(&intercepted) != 3 {
+		t.Fatalf("interceptor called %d times", atomic.LoadInt32(&intercepted))
+	}
+	// the stream the server ended is closed too
+	waitFor(t, func() bool { return streams.Count() == 0 })
+}
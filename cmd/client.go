@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -44,7 +45,7 @@ func main() {
 			Pair: &abi.Kvpair{
 				Key: "k1",
 				Value: &abi.Value{
-					Value: &abi.Value_String_{strings.Repeat("f", kvgo.COMPRESSION_LIMIT+1)},
+					Value: &abi.Value_String_{String_: strings.Repeat("f", kvgo.COMPRESSION_LIMIT+1)},
 				},
 			},
 		},
@@ -70,10 +71,10 @@ func main() {
 			Topic: "cae",
 			Data: []*abi.Value{
 				{
-					Value: &abi.Value_String_{"hello"},
+					Value: &abi.Value_String_{String_: "hello"},
 				},
 				{
-					Value: &abi.Value_String_{"world"},
+					Value: &abi.Value_String_{String_: "world"},
 				},
 			},
 		},
@@ -84,7 +85,7 @@ func main() {
 			Topic: "cae",
 			Data: []*abi.Value{
 				{
-					Value: &abi.Value_String_{"?"},
+					Value: &abi.Value_String_{String_: "?"},
 				},
 			},
 		},
@@ -118,14 +119,9 @@ func main() {
 
 	go func() {
 		time.Sleep(500 * time.Millisecond)
-		unsubscribe := &abi.CommandRequest_Unsubscribe{
-			Unsubscribe: &abi.Unsubscribe{
-				Topic: "cae",
-				Id:    sr.Id(),
-			},
-		}
-		_, err = client.Execute(&abi.CommandRequest{RequestData: unsubscribe})
-		if err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := sr.Close(ctx); err != nil {
 			panic(err)
 		}
 		l.Sugar().Info("unsubscribe success")
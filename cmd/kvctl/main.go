@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	kvgo "github.com/caelansar/kv-go"
+	"github.com/caelansar/kv-go/client"
+	"github.com/caelansar/kv-go/client/multiplex"
+	abi "github.com/caelansar/kv-go/pb"
+	"go.uber.org/zap"
+)
+
+var (
+	addr      = flag.String("addr", "127.0.0.1:5000", "server address")
+	transport = flag.String("transport", "quic", "quic or yamux")
+	timeout   = flag.Duration("timeout", 5*time.Second, "command timeout")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `usage: kvctl [flags] <command> [args]
+
+commands:
+  info [section...]                        print server statistics
+  monitor [-table t,...] [-command c,...]  print commands as the server executes them
+  slowlog [count]                          print the slow log, newest first
+  slowlog reset                            empty the slow log
+  topics [pattern]                         list the topics matching the glob pattern
+  topic <name>                             print the statistics of a topic
+  subscriptions [topic]                    list the subscriptions, to topic if given
+
+flags:
+`)
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	c, err := connect()
+	if err != nil {
+		fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "info":
+		err = info(ctx, c, args)
+	case "slowlog":
+		err = slowlog(ctx, c, args)
+	case "topics":
+		err = topics(ctx, c, args)
+	case "topic":
+		if len(args) != 1 {
+			usage()
+			os.Exit(2)
+		}
+		err = topic(ctx, c, args[0])
+	case "subscriptions":
+		err = subscriptions(ctx, c, args)
+	case "monitor":
+		// runs until interrupted, the timeout doesn't apply
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		err = monitor(ctx, c, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatal(err)
+	}
+}
+
+func connect() (*client.Client, error) {
+	tlsConfig, err := client.NewTlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	var session multiplex.Session
+	switch *transport {
+	case "quic":
+		session, err = multiplex.NewQuicSession(*addr, tlsConfig)
+	case "yamux":
+		session, err = multiplex.NewYamuxSession(*addr, tlsConfig)
+	default:
+		err = fmt.Errorf("unknown transport %q", *transport)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client.NewClient(zap.NewNop().Sugar(), &kvgo.DefaultCodec{}, session)
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "kvctl:", err)
+	os.Exit(1)
+}
+
+// info prints the pairs grouped by section, in the order the server sent them.
+func info(ctx context.Context, c *client.Client, sections []string) error {
+	pairs, err := c.Info(ctx, sections...)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	var section string
+	for _, pair := range pairs {
+		s, name := pair.Key, pair.Key
+		if i := strings.IndexByte(pair.Key, '.'); i >= 0 {
+			s, name = pair.Key[:i], pair.Key[i+1:]
+		}
+		if s != section {
+			if section != "" {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "# %s\n", s)
+			section = s
+		}
+		printValue(w, "", name, pair.Value)
+	}
+	return w.Flush()
+}
+
+func monitor(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("monitor", flag.ExitOnError)
+	tables := fs.String("table", "", "comma separated tables to watch, all if empty")
+	commands := fs.String("command", "", "comma separated commands to watch, all if empty")
+	fs.Parse(args)
+
+	res, err := c.Monitor(ctx, split(*tables), split(*commands))
+	if err != nil {
+		return err
+	}
+	for resp := range res.Chan() {
+		e := client.ParseMonitorEvent(resp)
+		fmt.Printf("%s %s %s %s\n", e.Time.Format(time.RFC3339Nano), e.Client, e.Command, e.Table)
+	}
+	return nil
+}
+
+func slowlog(ctx context.Context, c *client.Client, args []string) error {
+	var count uint64
+	if len(args) > 0 {
+		if args[0] == "reset" {
+			return c.SlowlogReset(ctx)
+		}
+		var err error
+		if count, err = strconv.ParseUint(args[0], 10, 32); err != nil {
+			return fmt.Errorf("invalid count %q", args[0])
+		}
+	}
+	entries, err := c.SlowlogGet(ctx, uint32(count))
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTIME\tDURATION\tCOMMAND\tTABLE\tKEYS\tCLIENT")
+	for _, e := range entries {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%s\n", e.Id, e.Time.Format(time.RFC3339), e.Duration, e.Command, e.Table, e.Keys, e.Client)
+	}
+	return w.Flush()
+}
+
+func topics(ctx context.Context, c *client.Client, args []string) error {
+	var pattern string
+	if len(args) > 0 {
+		pattern = args[0]
+	}
+	topics, err := c.ListTopics(ctx, pattern)
+	if err != nil {
+		return err
+	}
+	for _, topic := range topics {
+		fmt.Println(topic)
+	}
+	return nil
+}
+
+func topic(ctx context.Context, c *client.Client, name string) error {
+	info, err := c.TopicInfo(ctx, name)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "subscribers\t%d\n", info.Subscribers)
+	fmt.Fprintf(w, "retained_messages\t%d\n", info.RetainedMessages)
+	fmt.Fprintf(w, "retained_bytes\t%d\n", info.RetainedBytes)
+	fmt.Fprintf(w, "publish_rate\t%.2f/s\n", info.PublishRate)
+	return w.Flush()
+}
+
+func subscriptions(ctx context.Context, c *client.Client, args []string) error {
+	var topic string
+	if len(args) > 0 {
+		topic = args[0]
+	}
+	subs, err := c.ListSubscriptions(ctx, topic)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTOPIC\tCLIENT\tGROUP\tLAG")
+	for _, sub := range subs {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\n", sub.Id, sub.Topic, sub.Client, sub.Group, sub.Lag)
+	}
+	return w.Flush()
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// printValue writes maps, such as the per-command statistics, one entry per
+// indented line.
+func printValue(w *tabwriter.Writer, indent, name string, v *abi.Value) {
+	m := v.GetMap()
+	if m == nil {
+		fmt.Fprintf(w, "%s%s\t%s\n", indent, name, format(v))
+		return
+	}
+	fmt.Fprintf(w, "%s%s\t\n", indent, name)
+	for _, key := range sortedKeys(m.Values) {
+		printValue(w, indent+"  ", key, m.Values[key])
+	}
+}
+
+func format(v *abi.Value) string {
+	if list := v.GetList(); list != nil {
+		s := make([]string, len(list.Values))
+		for i, v := range list.Values {
+			s[i] = format(v)
+		}
+		return strings.Join(s, ", ")
+	}
+	switch x := v.Interface().(type) {
+	case nil:
+		return "-"
+	case []byte:
+		return fmt.Sprintf("%x", x)
+	case time.Time:
+		return x.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(x)
+	}
+}
+
+// sortedKeys orders numeric keys, like latency buckets, by value and the
+// others alphabetically.
+func sortedKeys(m map[string]*abi.Value) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if len(a) != len(b) && isNumber(a) && isNumber(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	})
+	return keys
+}
+
+func isNumber(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -2,101 +2,311 @@ package kvgo
 
 import (
 	"bytes"
-	"compress/gzip"
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
+	"sync"
 
 	abi "github.com/caelansar/kv-go/pb"
 	"github.com/golang/protobuf/proto"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
+// PROTOCOL_VERSION is sent in abi.Hello when a session opens.
+const PROTOCOL_VERSION = 1
+
+// A frame is a 4 bytes big endian header followed by the payload. Without
+// COMPRESSION_BIT the remaining 31 bits are the payload length; with it, bits
+// 28-30 hold the Compression and the low 28 bits the compressed length. When
+// checksums are negotiated the payload is followed by a CHECKSUM bytes CRC32C
+// of the header and payload, not counted in the length.
 const LENGTH = 4
+const CHECKSUM = 4
 const COMPRESSION_LIMIT = 1436
 const COMPRESSION_BIT uint32 = 1 << 31
+const ALGORITHM_SHIFT = 28
+const ALGORITHM_MASK uint32 = 0x7 << ALGORITHM_SHIFT
+const MAX_COMPRESSED_LENGTH = 1<<ALGORITHM_SHIFT - 1
+const MAX_LENGTH = 1<<31 - 1
+
+// Default limits of DefaultCodec, see MaxFrameSize and MaxDecompressedSize.
+const MAX_FRAME_SIZE = 16 << 20
+const MAX_DECOMPRESSED_SIZE = 64 << 20
+
+var ErrFrameTooLarge = errors.New("kvgo: frame too large")
+
+// FrameTooLargeError reports a frame exceeding the codec limits, it matches
+// ErrFrameTooLarge with errors.Is. Once Decode fails with it the rest of the
+// frame is left unread, so the stream must be discarded.
+type FrameTooLargeError struct {
+	// Size claimed by the header, or the bytes decompressed before giving up.
+	Size  int64
+	Limit int
+	// Decompressed is set when the limit was hit while decompressing.
+	Decompressed bool
+}
+
+func (e *FrameTooLargeError) Error() string {
+	if e.Decompressed {
+		return fmt.Sprintf("kvgo: decompressed frame exceeds %d bytes", e.Limit)
+	}
+	return fmt.Sprintf("kvgo: frame of %d bytes exceeds %d bytes", e.Size, e.Limit)
+}
+
+func (e *FrameTooLargeError) Is(target error) bool {
+	return target == ErrFrameTooLarge
+}
+
+var ErrCorruptFrame = errors.New("kvgo: corrupt frame")
+
+// CorruptFrameError reports a frame whose CRC32C trailer doesn't match its
+// content, it matches ErrCorruptFrame with errors.Is. The stream the frame was
+// read from can't be trusted anymore and must be discarded.
+type CorruptFrameError struct {
+	Expected uint32
+	Actual   uint32
+}
+
+func (e *CorruptFrameError) Error() string {
+	return fmt.Sprintf("kvgo: corrupt frame, checksum %08x, expected %08x", e.Actual, e.Expected)
+}
+
+func (e *CorruptFrameError) Is(target error) bool {
+	return target == ErrCorruptFrame
+}
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+func checksum(frame []byte) []byte {
+	var trailer [CHECKSUM]byte
+	binary.BigEndian.PutUint32(trailer[:], crc32.Checksum(frame, castagnoli))
+	return trailer[:]
+}
 
 type Codec interface {
-	Encode(*abi.CommandRequest) ([]byte, error)
+	Encode(io.Writer, *abi.CommandRequest) error
 	Decode(io.Reader) (*abi.CommandResponse, error)
 }
 
+// Negotiable is implemented by codecs whose frame options are agreed with the
+// server when a session opens, see client.Negotiate.
+type Negotiable interface {
+	// Compressions lists the algorithms the codec accepts, most preferred first.
+	Compressions() []Compression
+	// Checksum reports whether the codec asks for CRC32C trailers.
+	Checksum() bool
+	// Negotiated returns a copy of the codec compressing outgoing frames with
+	// compression and, if checksum is set, adding CRC32C trailers in both
+	// directions. The codec itself is left untouched, so a session can be
+	// renegotiated while streams still use the previous copy.
+	Negotiated(compression Compression, checksum bool) Codec
+}
+
+// DefaultCodec compresses frames larger than Threshold. Its fields must not be
+// changed once the codec is shared between streams.
 type DefaultCodec struct {
+	// Compression of outgoing frames, gzip if unset. client.NewClient offers
+	// it first in Negotiate and sends legacy gzip frames until the server
+	// accepts, see Negotiated.
+	Compression Compression
+	// Threshold is the payload size above which frames are compressed,
+	// COMPRESSION_LIMIT if zero. A negative value disables compression.
+	Threshold int
+	// Level is passed to the Compressor, 0 means the algorithm's default.
+	Level int
+	// MaxFrameSize bounds the payload of a frame as it is on the wire, in both
+	// directions. MAX_FRAME_SIZE if zero.
+	MaxFrameSize int
+	// MaxDecompressedSize bounds the payload of a compressed frame once
+	// decompressed. MAX_DECOMPRESSED_SIZE if zero.
+	MaxDecompressedSize int
+	// Crc32c adds a CRC32C trailer to every frame, both sides must agree on
+	// it. client.NewClient asks for it in Negotiate and leaves it off until the
+	// server accepts, see Negotiated.
+	Crc32c bool
+}
+
+func (d *DefaultCodec) Compressions() []Compression {
+	cs := []Compression{d.Compression}
+	for _, c := range Compressions() {
+		if c != d.Compression {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+func (d *DefaultCodec) Checksum() bool {
+	return d.Crc32c
+}
+
+func (d *DefaultCodec) Negotiated(compression Compression, checksum bool) Codec {
+	negotiated := *d
+	negotiated.Compression = compression
+	negotiated.Crc32c = checksum
+	return &negotiated
+}
+
+func (d *DefaultCodec) threshold() int {
+	if d.Threshold == 0 {
+		return COMPRESSION_LIMIT
+	}
+	return d.Threshold
+}
+
+func (d *DefaultCodec) maxFrameSize() int {
+	if d.MaxFrameSize == 0 {
+		return MAX_FRAME_SIZE
+	}
+	return d.MaxFrameSize
+}
+
+func (d *DefaultCodec) maxDecompressedSize() int {
+	if d.MaxDecompressedSize == 0 {
+		return MAX_DECOMPRESSED_SIZE
+	}
+	return d.MaxDecompressedSize
 }
 
-func (d *DefaultCodec) Encode(req *abi.CommandRequest) ([]byte, error) {
-	data, err := proto.Marshal(req)
+// Encode writes req as a single frame into w.
+func (d *DefaultCodec) Encode(w io.Writer, req *abi.CommandRequest) error {
+	// marshal right after room for the header, so the frame needs a single Write
+	b := getBytes(LENGTH)
+	defer putBytes(b)
+	frame, err := protov2.MarshalOptions{}.MarshalAppend(*b, proto.MessageV2(req))
+	*b = frame
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var b bytes.Buffer
+	data := frame[LENGTH:]
 	length := len(data)
 
-	if length > COMPRESSION_LIMIT {
-		var compressedBytes bytes.Buffer
-		w := gzip.NewWriter(&compressedBytes)
-		_, err = w.Write(data)
-		if err != nil {
-			return nil, err
-		}
-		if err = w.Flush(); err != nil {
-			return nil, err
+	if d.threshold() >= 0 && length > d.threshold() && d.Compression != CompressionNone {
+		buf := getBuffer()
+		defer putBuffer(buf)
+		buf.Write(frame[:LENGTH])
+		if err = compress(buf, d.Compression, d.Level, data); err != nil {
+			return err
 		}
-		if err = w.Close(); err != nil {
-			return nil, err
+		compressed := buf.Len() - LENGTH
+		// only worth it if the payload actually shrinks
+		if compressed < length && compressed <= MAX_COMPRESSED_LENGTH && compressed <= d.maxFrameSize() {
+			header := uint32(compressed) | uint32(d.Compression)<<ALGORITHM_SHIFT | COMPRESSION_BIT
+			binary.BigEndian.PutUint32(buf.Bytes(), header)
+			if d.Crc32c {
+				buf.Write(checksum(buf.Bytes()))
+			}
+			_, err = w.Write(buf.Bytes())
+			return err
 		}
-		err = binary.Write(&b, binary.BigEndian, uint32(len(compressedBytes.Bytes()))|COMPRESSION_BIT)
-		if err != nil {
-			return nil, err
-		}
-		b.Write(compressedBytes.Bytes())
-	} else {
-		err = binary.Write(&b, binary.BigEndian, uint32(length))
-		if err != nil {
-			return nil, err
-		}
-		b.Write(data)
 	}
-	return b.Bytes(), nil
+
+	if length > d.maxFrameSize() || length > MAX_LENGTH {
+		return &FrameTooLargeError{Size: int64(length), Limit: d.maxFrameSize()}
+	}
+	binary.BigEndian.PutUint32(frame, uint32(length))
+	if d.Crc32c {
+		frame = append(frame, checksum(frame)...)
+		*b = frame
+	}
+	_, err = w.Write(frame)
+	return err
+}
+
+func compress(w io.Writer, c Compression, level int, data []byte) error {
+	compressor, ok := compressors[c]
+	if !ok {
+		return fmt.Errorf("kvgo: unsupported compression %s", c)
+	}
+	cw, err := compressor.NewWriter(w, level)
+	if err != nil {
+		return err
+	}
+	if _, err = cw.Write(data); err != nil {
+		cw.Close()
+		return err
+	}
+	return cw.Close()
+}
+
+var readerPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Reader)
+	},
 }
 
 func (d *DefaultCodec) Decode(reader io.Reader) (resp *abi.CommandResponse, err error) {
-	var header uint32
+	b := getBytes(LENGTH)
+	defer putBytes(b)
 	// read 4 bytes header first
-	err = binary.Read(reader, binary.BigEndian, &header)
+	_, err = io.ReadFull(reader, *b)
 	if err != nil {
 		return nil, err
 	}
+	header := binary.BigEndian.Uint32(*b)
 
-	var length = header & (^COMPRESSION_BIT)
 	var compressed = header&COMPRESSION_BIT == COMPRESSION_BIT
+	var length = header & (^COMPRESSION_BIT)
+	var algorithm = CompressionNone
+	if compressed {
+		algorithm = Compression((header & ALGORITHM_MASK) >> ALGORITHM_SHIFT)
+		length = header & (^(COMPRESSION_BIT | ALGORITHM_MASK))
+	}
 
-	var b []byte
+	// never trust the header with the allocation size
+	if int64(length) > int64(d.maxFrameSize()) {
+		return nil, &FrameTooLargeError{Size: int64(length), Limit: d.maxFrameSize()}
+	}
+	trailer := 0
+	if d.Crc32c {
+		trailer = CHECKSUM
+	}
+	// keep the header in front of the payload, the checksum covers both
+	frame := resizeBytes(b, LENGTH+int(length)+trailer)
+	// read exactly `length` byte
+	_, err = io.ReadFull(reader, frame[LENGTH:])
+	if err != nil {
+		return nil, err
+	}
+	data := frame[LENGTH : LENGTH+length]
+	if d.Crc32c {
+		expected := binary.BigEndian.Uint32(frame[LENGTH+length:])
+		if actual := crc32.Checksum(frame[:LENGTH+length], castagnoli); actual != expected {
+			return nil, &CorruptFrameError{Expected: expected, Actual: actual}
+		}
+	}
 	if compressed {
-		b = make([]byte, length)
-		// read exactly `length` byte
-		_, err = io.ReadFull(reader, b)
-		if err != nil {
-			return nil, err
+		compressor, ok := compressors[algorithm]
+		if !ok {
+			return nil, fmt.Errorf("kvgo: unsupported compression %s", algorithm)
 		}
-		r := bytes.NewReader(b)
-		// new gzip reader with compressed data
-		reader, err = gzip.NewReader(r)
+		br := readerPool.Get().(*bytes.Reader)
+		br.Reset(data)
+		defer readerPool.Put(br)
+		// new reader with compressed data
+		r, err := compressor.NewReader(br)
 		if err != nil {
 			return nil, err
 		}
-		// uncompressed
-		b, err = io.ReadAll(reader)
+		// uncompressed, reading one byte past the limit to detect decompression bombs
+		limit := d.maxDecompressedSize()
+		buf := getBuffer()
+		defer putBuffer(buf)
+		_, err = buf.ReadFrom(io.LimitReader(r, int64(limit)+1))
+		r.Close()
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		b = make([]byte, length)
-		_, err = io.ReadFull(reader, b)
-		if err != nil {
-			return nil, err
+		if buf.Len() > limit {
+			return nil, &FrameTooLargeError{Size: int64(buf.Len()), Limit: limit, Decompressed: true}
 		}
+		data = buf.Bytes()
 	}
+	// Unmarshal copies what it keeps, the pooled buffers can be reused
 	resp = &abi.CommandResponse{}
-	err = proto.Unmarshal(b, resp)
+	err = proto.Unmarshal(data, resp)
 	if err != nil {
 		return nil, err
 	}
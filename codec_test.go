@@ -3,9 +3,23 @@ package kvgo
 import (
 	"bytes"
 	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
+	"math"
+	"math/rand"
+	"reflect"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
+
+	abi "github.com/caelansar/kv-go/pb"
+	"github.com/golang/protobuf/proto"
 )
 
 func TestGzip(t *testing.T) {
@@ -32,3 +46,392 @@ func TestGzip(t *testing.T) {
 		t.Fatal("failed")
 	}
 }
+
+func TestValueRoundTrip(t *testing.T) {
+	ts := time.Date(2022, 12, 1, 8, 30, 0, 500, time.UTC)
+	cases := []struct {
+		name string
+		in   interface{}
+		out  interface{}
+	}{
+		{"string", "v", "v"},
+		{"binary", []byte("v"), []byte("v")},
+		{"integer", 42, int64(42)},
+		{"unsigned", uint64(math.MaxInt64), int64(math.MaxInt64)},
+		{"float", 1.5, 1.5},
+		{"bool", true, true},
+		{"timestamp", ts, ts},
+		{"duration", 3 * time.Second, 3 * time.Second},
+		{"list", []interface{}{"a", 1}, []interface{}{"a", int64(1)}},
+		{"map", map[string]interface{}{"a": []string{"b"}}, map[string]interface{}{"a": []interface{}{"b"}}},
+		{"null", nil, nil},
+		{"large", strings.Repeat("a", COMPRESSION_LIMIT+1), strings.Repeat("a", COMPRESSION_LIMIT+1)},
+	}
+
+	codec := &DefaultCodec{}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := abi.ValueOf(c.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := encode(codec, &abi.CommandRequest{RequestData: &abi.CommandRequest_Hset{
+				Hset: &abi.Hset{Table: "t", Pair: &abi.Kvpair{Key: "k", Value: v}},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := decodeRequest(t, b)
+			if got := req.GetHset().GetPair().GetValue().Interface(); !reflect.DeepEqual(got, c.out) {
+				t.Fatalf("request: got %#v, want %#v", got, c.out)
+			}
+
+			resp, err := codec.Decode(bytes.NewReader(encodeResponse(t, &abi.CommandResponse{
+				Status: 200,
+				Values: []*abi.Value{v},
+			})))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := resp.Values[0].Interface(); !reflect.DeepEqual(got, c.out) {
+				t.Fatalf("response: got %#v, want %#v", got, c.out)
+			}
+			if c.in == nil && !resp.Values[0].IsNull() {
+				t.Fatal("expected explicit null")
+			}
+		})
+	}
+}
+
+func TestValueOfOverflow(t *testing.T) {
+	if _, err := abi.ValueOf(uint64(math.MaxUint64)); err == nil {
+		t.Fatal("expected an error for a uint64 above math.MaxInt64")
+	}
+	if _, err := abi.ValueOf([]uint64{1, math.MaxInt64 + 1}); err == nil {
+		t.Fatal("expected an error for an out of range list element")
+	}
+}
+
+func encode(codec *DefaultCodec, req *abi.CommandRequest) ([]byte, error) {
+	var b bytes.Buffer
+	err := codec.Encode(&b, req)
+	return b.Bytes(), err
+}
+
+// decodeRequest parses a frame produced by Encode, the way the server would.
+func decodeRequest(t testing.TB, b []byte) *abi.CommandRequest {
+	t.Helper()
+	header := binary.BigEndian.Uint32(b[:LENGTH])
+	data := b[LENGTH:]
+	length := header &^ COMPRESSION_BIT
+	if header&COMPRESSION_BIT != 0 {
+		length = header &^ (COMPRESSION_BIT | ALGORITHM_MASK)
+	}
+	if int(length) != len(data) {
+		t.Fatalf("header length %d, frame length %d", length, len(data))
+	}
+	if header&COMPRESSION_BIT != 0 {
+		r, err := compressors[Compression(header&ALGORITHM_MASK>>ALGORITHM_SHIFT)].NewReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, err = io.ReadAll(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := &abi.CommandRequest{}
+	if err := proto.Unmarshal(data, req); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+// encodeResponse frames a response the way the server would, using the legacy
+// gzip header for large payloads.
+func encodeResponse(t testing.TB, resp *abi.CommandResponse) []byte {
+	return encodeResponseWith(t, resp, CompressionGzip)
+}
+
+func encodeResponseWith(t testing.TB, resp *abi.CommandResponse, c Compression) []byte {
+	t.Helper()
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	header := uint32(len(data))
+	if len(data) > COMPRESSION_LIMIT && c != CompressionNone {
+		var compressed bytes.Buffer
+		if err = compress(&compressed, c, 0, data); err != nil {
+			t.Fatal(err)
+		}
+		data = compressed.Bytes()
+		header = uint32(len(data)) | uint32(c)<<ALGORITHM_SHIFT | COMPRESSION_BIT
+	}
+	binary.Write(&b, binary.BigEndian, header)
+	b.Write(data)
+	return b.Bytes()
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	large := strings.Repeat("compressible ", COMPRESSION_LIMIT)
+	for _, c := range append(Compressions(), CompressionNone) {
+		for _, level := range []int{0, 1} {
+			t.Run(fmt.Sprintf("%s/%d", c, level), func(t *testing.T) {
+				codec := &DefaultCodec{Compression: c, Level: level}
+				b, err := encode(codec, hsetRequest(large))
+				if err != nil {
+					t.Fatal(err)
+				}
+				header := binary.BigEndian.Uint32(b[:LENGTH])
+				if compressed := header&COMPRESSION_BIT != 0; compressed != (c != CompressionNone) {
+					t.Fatalf("compressed = %v", compressed)
+				}
+				if compressed := header&COMPRESSION_BIT != 0; compressed && Compression(header&ALGORITHM_MASK>>ALGORITHM_SHIFT) != c {
+					t.Fatalf("header algorithm %d", header&ALGORITHM_MASK>>ALGORITHM_SHIFT)
+				}
+				if got := decodeRequest(t, b).GetHset().GetPair().GetValue().GetString_(); got != large {
+					t.Fatal("request mismatch")
+				}
+
+				resp, err := codec.Decode(bytes.NewReader(encodeResponseWith(t, stringResponse(large), c)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.Values[0].GetString_() != large {
+					t.Fatal("response mismatch")
+				}
+			})
+		}
+	}
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	payload := strings.Repeat("a", 200)
+	cases := []struct {
+		threshold  int
+		compressed bool
+	}{
+		{0, false},
+		{100, true},
+		{-1, false},
+	}
+	for _, c := range cases {
+		b, err := encode(&DefaultCodec{Threshold: c.threshold}, hsetRequest(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if compressed := binary.BigEndian.Uint32(b)&COMPRESSION_BIT != 0; compressed != c.compressed {
+			t.Fatalf("threshold %d: compressed = %v", c.threshold, compressed)
+		}
+	}
+}
+
+func TestDecodeLegacyGzipFrame(t *testing.T) {
+	data, err := proto.Marshal(stringResponse(strings.Repeat("a", 2400)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	w.Write(data)
+	w.Close()
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(compressed.Len())|COMPRESSION_BIT)
+	b.Write(compressed.Bytes())
+
+	resp, err := (&DefaultCodec{Compression: CompressionZstd}).Decode(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Values[0].GetString_()) != 2400 {
+		t.Fatal("legacy frame mismatch")
+	}
+}
+
+func TestDecodeUnknownCompression(t *testing.T) {
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(1)|uint32(5)<<ALGORITHM_SHIFT|COMPRESSION_BIT)
+	b.WriteByte(0)
+	if _, err := (&DefaultCodec{}).Decode(&b); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func hsetRequest(s string) *abi.CommandRequest {
+	return &abi.CommandRequest{RequestData: &abi.CommandRequest_Hset{
+		Hset: &abi.Hset{Table: "t", Pair: &abi.Kvpair{Key: "k", Value: &abi.Value{Value: &abi.Value_String_{String_: s}}}},
+	}}
+}
+
+func stringResponse(s string) *abi.CommandResponse {
+	return &abi.CommandResponse{Status: 200, Values: []*abi.Value{{Value: &abi.Value_String_{String_: s}}}}
+}
+
+func TestDecodeFrameTooLarge(t *testing.T) {
+	var b bytes.Buffer
+	// the header claims ~2GiB, Decode must fail before allocating it
+	binary.Write(&b, binary.BigEndian, uint32(MAX_LENGTH))
+	_, err := (&DefaultCodec{}).Decode(&b)
+	var tooLarge *FrameTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Decompressed || tooLarge.Limit != MAX_FRAME_SIZE {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	frame := encodeResponseWith(t, stringResponse(strings.Repeat("a", 2400)), CompressionNone)
+	_, err = (&DefaultCodec{MaxFrameSize: 1024}).Decode(bytes.NewReader(frame))
+	if !errors.Is(err, ErrFrameTooLarge) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestDecodeDecompressionBomb(t *testing.T) {
+	for _, c := range Compressions() {
+		t.Run(c.String(), func(t *testing.T) {
+			frame := encodeResponseWith(t, stringResponse(strings.Repeat("a", 1<<20)), c)
+			if len(frame) > 64<<10 {
+				t.Fatalf("frame of %d bytes is not a bomb", len(frame))
+			}
+			_, err := (&DefaultCodec{MaxDecompressedSize: 64 << 10}).Decode(bytes.NewReader(frame))
+			var tooLarge *FrameTooLargeError
+			if !errors.As(err, &tooLarge) || !tooLarge.Decompressed {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestEncodeFrameTooLarge(t *testing.T) {
+	codec := &DefaultCodec{Compression: CompressionNone, MaxFrameSize: 1024}
+	if _, err := encode(codec, hsetRequest(strings.Repeat("a", 2048))); !errors.Is(err, ErrFrameTooLarge) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// compression brings the frame under the limit
+	codec.Compression = CompressionGzip
+	if _, err := encode(codec, hsetRequest(strings.Repeat("a", 2048))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func FuzzEncode(f *testing.F) {
+	f.Add("k", []byte("v"), 0)
+	f.Add("k", bytes.Repeat([]byte("v"), 2*COMPRESSION_LIMIT), 1)
+	f.Add("", []byte{}, 2)
+	f.Fuzz(func(t *testing.T, key string, value []byte, c int) {
+		if !utf8.ValidString(key) {
+			t.Skip("proto3 string fields must be valid UTF-8")
+		}
+		compression := append(Compressions(), CompressionNone)[uint(c)%uint(len(Compressions())+1)]
+		req := &abi.CommandRequest{RequestData: &abi.CommandRequest_Hset{
+			Hset: &abi.Hset{Table: "t", Pair: &abi.Kvpair{Key: key, Value: &abi.Value{Value: &abi.Value_Binary{Binary: value}}}},
+		}}
+		b, err := encode(&DefaultCodec{Compression: compression}, req)
+		if errors.Is(err, ErrFrameTooLarge) {
+			t.Skip(err)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := decodeRequest(t, b); !proto.Equal(got, req) {
+			t.Fatalf("got %v, want %v", got, req)
+		}
+	})
+}
+
+func FuzzDecode(f *testing.F) {
+	for _, c := range append(Compressions(), CompressionNone) {
+		f.Add(encodeResponseWith(f, stringResponse(strings.Repeat("a", 2*COMPRESSION_LIMIT)), c))
+	}
+	f.Add(encodeResponse(f, stringResponse("a")))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+	f.Fuzz(func(t *testing.T, frame []byte) {
+		codec := &DefaultCodec{MaxFrameSize: 1 << 16, MaxDecompressedSize: 1 << 16}
+		resp, err := codec.Decode(bytes.NewReader(frame))
+		if err != nil {
+			return
+		}
+		if size := proto.Size(resp); size > codec.MaxDecompressedSize {
+			t.Fatalf("decoded %d bytes over the limit", size)
+		}
+	})
+}
+
+func BenchmarkEncode(b *testing.B) {
+	for _, size := range []int{64, 16 << 10} {
+		req := hsetRequest(strings.Repeat("a", size))
+		codec := &DefaultCodec{}
+		b.Run(fmt.Sprintf("%dB", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := codec.Encode(io.Discard, req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	for _, size := range []int{64, 16 << 10} {
+		frame := encodeResponse(b, stringResponse(strings.Repeat("a", size)))
+		codec := &DefaultCodec{}
+		b.Run(fmt.Sprintf("%dB", size), func(b *testing.B) {
+			b.ReportAllocs()
+			r := bytes.NewReader(frame)
+			for i := 0; i < b.N; i++ {
+				r.Reset(frame)
+				if _, err := codec.Decode(r); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// incompressible returns n random base64 characters, which still exceed n/2
+// bytes once compressed.
+func incompressible(n int) string {
+	b := make([]byte, n)
+	rand.New(rand.NewSource(1)).Read(b)
+	return base64.StdEncoding.EncodeToString(b)[:n]
+}
+
+func TestChecksum(t *testing.T) {
+	// frames larger than the pooled buffers must keep their header checksummed
+	for _, payload := range []string{"a", strings.Repeat("a", 2*COMPRESSION_LIMIT), incompressible(4 * COMPRESSION_LIMIT), incompressible(100 << 10)} {
+		codec := &DefaultCodec{Crc32c: true}
+		b, err := encode(codec, hsetRequest(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		frame, trailer := b[:len(b)-CHECKSUM], b[len(b)-CHECKSUM:]
+		if binary.BigEndian.Uint32(trailer) != crc32.Checksum(frame, crc32.MakeTable(crc32.Castagnoli)) {
+			t.Fatal("bad trailer")
+		}
+		if decodeRequest(t, frame).GetHset().GetPair().GetValue().GetString_() != payload {
+			t.Fatal("request mismatch")
+		}
+
+		frame = encodeResponse(t, stringResponse(payload))
+		frame = append(frame, checksum(frame)...)
+		// empty the pools so that Decode grows a buffer of the default capacity
+		runtime.GC()
+		runtime.GC()
+		resp, err := codec.Decode(bytes.NewReader(frame))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Values[0].GetString_() != payload {
+			t.Fatal("response mismatch")
+		}
+
+		frame[len(frame)/2] ^= 1
+		_, err = codec.Decode(bytes.NewReader(frame))
+		var corrupt *CorruptFrameError
+		if !errors.As(err, &corrupt) || !errors.Is(err, ErrCorruptFrame) {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package kvgo
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Compression identifies the algorithm of a compressed frame, it is stored in
+// the ALGORITHM_MASK bits of the header.
+type Compression uint8
+
+const (
+	// CompressionGzip is 0 so that frames written with only COMPRESSION_BIT
+	// set still decode as gzip.
+	CompressionGzip Compression = iota
+	CompressionZstd
+	CompressionSnappy
+	CompressionLz4
+	// CompressionNone disables compression, it never appears in a frame header.
+	CompressionNone Compression = 7
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionGzip:
+		return "gzip"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionLz4:
+		return "lz4"
+	case CompressionNone:
+		return "none"
+	}
+	return fmt.Sprintf("compression(%d)", uint8(c))
+}
+
+// Compressor creates the streams used to compress and decompress frame payloads.
+// A level of 0 selects the algorithm's default.
+type Compressor interface {
+	NewWriter(w io.Writer, level int) (io.WriteCloser, error)
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+var compressors = map[Compression]Compressor{
+	CompressionGzip:   gzipCompressor{},
+	CompressionZstd:   zstdCompressor{},
+	CompressionSnappy: snappyCompressor{},
+	CompressionLz4:    lz4Compressor{},
+}
+
+// RegisterCompressor adds or replaces the implementation of c. It is not safe
+// to call concurrently with Encode or Decode.
+func RegisterCompressor(c Compression, compressor Compressor) {
+	if c >= CompressionNone {
+		panic(fmt.Sprintf("kvgo: invalid compression id %d", c))
+	}
+	compressors[c] = compressor
+}
+
+// Compressions returns every registered algorithm.
+func Compressions() []Compression {
+	var cs []Compression
+	for c := CompressionGzip; c < CompressionNone; c++ {
+		if _, ok := compressors[c]; ok {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// gzipCompressor pools its writers, one pool per level, and its readers. Close
+// returns them to the pool, so they must not be used afterwards.
+type gzipCompressor struct{}
+
+var gzipWriterPools sync.Map // map[int]*sync.Pool
+var gzipReaderPool sync.Pool
+
+type pooledGzipWriter struct {
+	*gzip.Writer
+	pool *sync.Pool
+}
+
+func (w *pooledGzipWriter) Close() error {
+	err := w.Writer.Close()
+	w.Writer.Reset(nil)
+	w.pool.Put(w)
+	return err
+}
+
+type pooledGzipReader struct {
+	*gzip.Reader
+}
+
+func (r *pooledGzipReader) Close() error {
+	err := r.Reader.Close()
+	gzipReaderPool.Put(r)
+	return err
+}
+
+func (gzipCompressor) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+		return nil, fmt.Errorf("gzip: invalid compression level: %d", level)
+	}
+	p, ok := gzipWriterPools.Load(level)
+	if !ok {
+		p, _ = gzipWriterPools.LoadOrStore(level, &sync.Pool{})
+	}
+	pool := p.(*sync.Pool)
+	if pw, ok := pool.Get().(*pooledGzipWriter); ok {
+		pw.Reset(w)
+		return pw, nil
+	}
+	gw, err := gzip.NewWriterLevel(w, level)
+	if err != nil {
+		return nil, err
+	}
+	return &pooledGzipWriter{Writer: gw, pool: pool}, nil
+}
+
+func (gzipCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	if pr, ok := gzipReaderPool.Get().(*pooledGzipReader); ok {
+		if err := pr.Reset(r); err != nil {
+			gzipReaderPool.Put(pr)
+			return nil, err
+		}
+		return pr, nil
+	}
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &pooledGzipReader{Reader: gr}, nil
+}
+
+type zstdCompressor struct{}
+
+func (zstdCompressor) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	opts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+	if level != 0 {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+	return zstd.NewWriter(w, opts...)
+}
+
+func (zstdCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+type snappyCompressor struct{}
+
+func (snappyCompressor) NewWriter(w io.Writer, _ int) (io.WriteCloser, error) {
+	return snappy.NewBufferedWriter(w), nil
+}
+
+func (snappyCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(snappy.NewReader(r)), nil
+}
+
+type lz4Compressor struct{}
+
+// NewWriter maps level 1-9 to lz4.Level1-lz4.Level9.
+func (lz4Compressor) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	zw := lz4.NewWriter(w)
+	if level > 0 {
+		if level > 9 {
+			level = 9
+		}
+		if err := zw.Apply(lz4.CompressionLevelOption(lz4.CompressionLevel(1 << (8 + level)))); err != nil {
+			return nil, err
+		}
+	}
+	return zw, nil
+}
+
+func (lz4Compressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(lz4.NewReader(r)), nil
+}
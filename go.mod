@@ -5,11 +5,14 @@ go 1.18
 require (
 	github.com/golang/protobuf v1.5.2
 	github.com/hashicorp/yamux v0.1.1
+	github.com/klauspost/compress v1.15.12
 	github.com/lucas-clemente/quic-go v0.31.0
+	github.com/pierrec/lz4/v4 v4.1.17
 	github.com/satori/go.uuid v1.2.0
 	go.uber.org/zap v1.21.0
 	golang.org/x/net v0.0.0-20220722155237-a158d28d115b
 	golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4
+	google.golang.org/protobuf v1.28.0
 )
 
 require (
@@ -27,5 +30,4 @@ require (
 	golang.org/x/sys v0.1.1-0.20221102194838-fc697a31fa06 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	golang.org/x/tools v0.1.12 // indirect
-	google.golang.org/protobuf v1.28.0 // indirect
 )
@@ -6,6 +6,8 @@ package abi
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	math "math"
 )
 
@@ -20,6 +22,204 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Frame compression algorithm, the numbers match the frame header
+type Compression int32
+
+const (
+	Compression_GZIP   Compression = 0
+	Compression_ZSTD   Compression = 1
+	Compression_SNAPPY Compression = 2
+	Compression_LZ4    Compression = 3
+	Compression_NONE   Compression = 7
+)
+
+var Compression_name = map[int32]string{
+	0: "GZIP",
+	1: "ZSTD",
+	2: "SNAPPY",
+	3: "LZ4",
+	7: "NONE",
+}
+
+var Compression_value = map[string]int32{
+	"GZIP":   0,
+	"ZSTD":   1,
+	"SNAPPY": 2,
+	"LZ4":    3,
+	"NONE":   7,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{0}
+}
+
+type Op int32
+
+const (
+	Op_EQ Op = 0
+	Op_NE Op = 1
+	Op_LT Op = 2
+	Op_LE Op = 3
+	Op_GT Op = 4
+	Op_GE Op = 5
+	// String or binary prefix
+	Op_PREFIX Op = 6
+	// Substring, or list element equal to value
+	Op_CONTAINS Op = 7
+)
+
+var Op_name = map[int32]string{
+	0: "EQ",
+	1: "NE",
+	2: "LT",
+	3: "LE",
+	4: "GT",
+	5: "GE",
+	6: "PREFIX",
+	7: "CONTAINS",
+}
+
+var Op_value = map[string]int32{
+	"EQ":       0,
+	"NE":       1,
+	"LT":       2,
+	"LE":       3,
+	"GT":       4,
+	"GE":       5,
+	"PREFIX":   6,
+	"CONTAINS": 7,
+}
+
+func (x Op) String() string {
+	return proto.EnumName(Op_name, int32(x))
+}
+
+func (Op) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{1}
+}
+
+type StartPosition int32
+
+const (
+	// Only messages published after subscribing
+	StartPosition_LATEST StartPosition = 0
+	// The oldest message still retained
+	StartPosition_EARLIEST StartPosition = 1
+	// The message at offset, or the oldest retained after it
+	StartPosition_OFFSET StartPosition = 2
+)
+
+var StartPosition_name = map[int32]string{
+	0: "LATEST",
+	1: "EARLIEST",
+	2: "OFFSET",
+}
+
+var StartPosition_value = map[string]int32{
+	"LATEST":   0,
+	"EARLIEST": 1,
+	"OFFSET":   2,
+}
+
+func (x StartPosition) String() string {
+	return proto.EnumName(StartPosition_name, int32(x))
+}
+
+func (StartPosition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{2}
+}
+
+type StreamFrame int32
+
+const (
+	StreamFrame_DATA StreamFrame = 0
+	// Sent every heartbeat interval asked for, carries nothing
+	StreamFrame_HEARTBEAT StreamFrame = 1
+	StreamFrame_END       StreamFrame = 2
+)
+
+var StreamFrame_name = map[int32]string{
+	0: "DATA",
+	1: "HEARTBEAT",
+	2: "END",
+}
+
+var StreamFrame_value = map[string]int32{
+	"DATA":      0,
+	"HEARTBEAT": 1,
+	"END":       2,
+}
+
+func (x StreamFrame) String() string {
+	return proto.EnumName(StreamFrame_name, int32(x))
+}
+
+func (StreamFrame) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{3}
+}
+
+type EndReason int32
+
+const (
+	EndReason_UNKNOWN         EndReason = 0
+	EndReason_UNSUBSCRIBED    EndReason = 1
+	EndReason_SERVER_SHUTDOWN EndReason = 2
+	// Closed by an administrator or for lagging behind
+	EndReason_KICKED EndReason = 3
+	EndReason_ERROR  EndReason = 4
+)
+
+var EndReason_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "UNSUBSCRIBED",
+	2: "SERVER_SHUTDOWN",
+	3: "KICKED",
+	4: "ERROR",
+}
+
+var EndReason_value = map[string]int32{
+	"UNKNOWN":         0,
+	"UNSUBSCRIBED":    1,
+	"SERVER_SHUTDOWN": 2,
+	"KICKED":          3,
+	"ERROR":           4,
+}
+
+func (x EndReason) String() string {
+	return proto.EnumName(EndReason_name, int32(x))
+}
+
+func (EndReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{4}
+}
+
+// Explicit null, distinct from an unset value
+type Null int32
+
+const (
+	Null_NULL_VALUE Null = 0
+)
+
+var Null_name = map[int32]string{
+	0: "NULL_VALUE",
+}
+
+var Null_value = map[string]int32{
+	"NULL_VALUE": 0,
+}
+
+func (x Null) String() string {
+	return proto.EnumName(Null_name, int32(x))
+}
+
+func (Null) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{5}
+}
+
 // Request from client
 type CommandRequest struct {
 	// Types that are valid to be assigned to RequestData:
@@ -35,10 +235,34 @@ type CommandRequest struct {
 	//	*CommandRequest_Subscribe
 	//	*CommandRequest_Unsubscribe
 	//	*CommandRequest_Publish
-	RequestData          isCommandRequest_RequestData `protobuf_oneof:"request_data"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	//	*CommandRequest_Negotiate
+	//	*CommandRequest_Hello
+	//	*CommandRequest_Ping
+	//	*CommandRequest_Echo
+	//	*CommandRequest_Info
+	//	*CommandRequest_Monitor
+	//	*CommandRequest_SlowlogGet
+	//	*CommandRequest_SlowlogReset
+	//	*CommandRequest_Watch
+	//	*CommandRequest_Psubscribe
+	//	*CommandRequest_Punsubscribe
+	//	*CommandRequest_ConfigureTopic
+	//	*CommandRequest_ConfigureGroup
+	//	*CommandRequest_Ack
+	//	*CommandRequest_Nack
+	//	*CommandRequest_ListScheduled
+	//	*CommandRequest_CancelScheduled
+	//	*CommandRequest_ListTopics
+	//	*CommandRequest_TopicInfo
+	//	*CommandRequest_ListSubscriptions
+	RequestData isCommandRequest_RequestData `protobuf_oneof:"request_data"`
+	// Request id, trace context, auth token, client name...
+	Metadata map[string]string `protobuf:"bytes,100,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The server abandons the request once the deadline passed
+	Deadline             *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *CommandRequest) Reset()         { *m = CommandRequest{} }
@@ -118,6 +342,86 @@ type CommandRequest_Publish struct {
 	Publish *Publish `protobuf:"bytes,12,opt,name=publish,proto3,oneof"`
 }
 
+type CommandRequest_Negotiate struct {
+	Negotiate *Negotiate `protobuf:"bytes,13,opt,name=negotiate,proto3,oneof"`
+}
+
+type CommandRequest_Hello struct {
+	Hello *Hello `protobuf:"bytes,14,opt,name=hello,proto3,oneof"`
+}
+
+type CommandRequest_Ping struct {
+	Ping *Ping `protobuf:"bytes,15,opt,name=ping,proto3,oneof"`
+}
+
+type CommandRequest_Echo struct {
+	Echo *Echo `protobuf:"bytes,16,opt,name=echo,proto3,oneof"`
+}
+
+type CommandRequest_Info struct {
+	Info *Info `protobuf:"bytes,17,opt,name=info,proto3,oneof"`
+}
+
+type CommandRequest_Monitor struct {
+	Monitor *Monitor `protobuf:"bytes,18,opt,name=monitor,proto3,oneof"`
+}
+
+type CommandRequest_SlowlogGet struct {
+	SlowlogGet *SlowlogGet `protobuf:"bytes,19,opt,name=slowlog_get,json=slowlogGet,proto3,oneof"`
+}
+
+type CommandRequest_SlowlogReset struct {
+	SlowlogReset *SlowlogReset `protobuf:"bytes,20,opt,name=slowlog_reset,json=slowlogReset,proto3,oneof"`
+}
+
+type CommandRequest_Watch struct {
+	Watch *Watch `protobuf:"bytes,21,opt,name=watch,proto3,oneof"`
+}
+
+type CommandRequest_Psubscribe struct {
+	Psubscribe *Psubscribe `protobuf:"bytes,22,opt,name=psubscribe,proto3,oneof"`
+}
+
+type CommandRequest_Punsubscribe struct {
+	Punsubscribe *Punsubscribe `protobuf:"bytes,23,opt,name=punsubscribe,proto3,oneof"`
+}
+
+type CommandRequest_ConfigureTopic struct {
+	ConfigureTopic *ConfigureTopic `protobuf:"bytes,24,opt,name=configure_topic,json=configureTopic,proto3,oneof"`
+}
+
+type CommandRequest_ConfigureGroup struct {
+	ConfigureGroup *ConfigureGroup `protobuf:"bytes,25,opt,name=configure_group,json=configureGroup,proto3,oneof"`
+}
+
+type CommandRequest_Ack struct {
+	Ack *Ack `protobuf:"bytes,26,opt,name=ack,proto3,oneof"`
+}
+
+type CommandRequest_Nack struct {
+	Nack *Nack `protobuf:"bytes,27,opt,name=nack,proto3,oneof"`
+}
+
+type CommandRequest_ListScheduled struct {
+	ListScheduled *ListScheduled `protobuf:"bytes,28,opt,name=list_scheduled,json=listScheduled,proto3,oneof"`
+}
+
+type CommandRequest_CancelScheduled struct {
+	CancelScheduled *CancelScheduled `protobuf:"bytes,29,opt,name=cancel_scheduled,json=cancelScheduled,proto3,oneof"`
+}
+
+type CommandRequest_ListTopics struct {
+	ListTopics *ListTopics `protobuf:"bytes,30,opt,name=list_topics,json=listTopics,proto3,oneof"`
+}
+
+type CommandRequest_TopicInfo struct {
+	TopicInfo *TopicInfo `protobuf:"bytes,31,opt,name=topic_info,json=topicInfo,proto3,oneof"`
+}
+
+type CommandRequest_ListSubscriptions struct {
+	ListSubscriptions *ListSubscriptions `protobuf:"bytes,32,opt,name=list_subscriptions,json=listSubscriptions,proto3,oneof"`
+}
+
 func (*CommandRequest_Hget) isCommandRequest_RequestData() {}
 
 func (*CommandRequest_Hgetall) isCommandRequest_RequestData() {}
@@ -142,6 +446,46 @@ func (*CommandRequest_Unsubscribe) isCommandRequest_RequestData() {}
 
 func (*CommandRequest_Publish) isCommandRequest_RequestData() {}
 
+func (*CommandRequest_Negotiate) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Hello) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Ping) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Echo) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Info) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Monitor) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_SlowlogGet) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_SlowlogReset) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Watch) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Psubscribe) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Punsubscribe) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_ConfigureTopic) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_ConfigureGroup) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Ack) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_Nack) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_ListScheduled) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_CancelScheduled) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_ListTopics) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_TopicInfo) isCommandRequest_RequestData() {}
+
+func (*CommandRequest_ListSubscriptions) isCommandRequest_RequestData() {}
+
 func (m *CommandRequest) GetRequestData() isCommandRequest_RequestData {
 	if m != nil {
 		return m.RequestData
@@ -225,163 +569,1605 @@ func (m *CommandRequest) GetUnsubscribe() *Unsubscribe {
 	}
 	return nil
 }
-
-func (m *CommandRequest) GetPublish() *Publish {
-	if x, ok := m.GetRequestData().(*CommandRequest_Publish); ok {
-		return x.Publish
-	}
-	return nil
+
+func (m *CommandRequest) GetPublish() *Publish {
+	if x, ok := m.GetRequestData().(*CommandRequest_Publish); ok {
+		return x.Publish
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetNegotiate() *Negotiate {
+	if x, ok := m.GetRequestData().(*CommandRequest_Negotiate); ok {
+		return x.Negotiate
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetHello() *Hello {
+	if x, ok := m.GetRequestData().(*CommandRequest_Hello); ok {
+		return x.Hello
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetPing() *Ping {
+	if x, ok := m.GetRequestData().(*CommandRequest_Ping); ok {
+		return x.Ping
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetEcho() *Echo {
+	if x, ok := m.GetRequestData().(*CommandRequest_Echo); ok {
+		return x.Echo
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetInfo() *Info {
+	if x, ok := m.GetRequestData().(*CommandRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetMonitor() *Monitor {
+	if x, ok := m.GetRequestData().(*CommandRequest_Monitor); ok {
+		return x.Monitor
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetSlowlogGet() *SlowlogGet {
+	if x, ok := m.GetRequestData().(*CommandRequest_SlowlogGet); ok {
+		return x.SlowlogGet
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetSlowlogReset() *SlowlogReset {
+	if x, ok := m.GetRequestData().(*CommandRequest_SlowlogReset); ok {
+		return x.SlowlogReset
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetWatch() *Watch {
+	if x, ok := m.GetRequestData().(*CommandRequest_Watch); ok {
+		return x.Watch
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetPsubscribe() *Psubscribe {
+	if x, ok := m.GetRequestData().(*CommandRequest_Psubscribe); ok {
+		return x.Psubscribe
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetPunsubscribe() *Punsubscribe {
+	if x, ok := m.GetRequestData().(*CommandRequest_Punsubscribe); ok {
+		return x.Punsubscribe
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetConfigureTopic() *ConfigureTopic {
+	if x, ok := m.GetRequestData().(*CommandRequest_ConfigureTopic); ok {
+		return x.ConfigureTopic
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetConfigureGroup() *ConfigureGroup {
+	if x, ok := m.GetRequestData().(*CommandRequest_ConfigureGroup); ok {
+		return x.ConfigureGroup
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetAck() *Ack {
+	if x, ok := m.GetRequestData().(*CommandRequest_Ack); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetNack() *Nack {
+	if x, ok := m.GetRequestData().(*CommandRequest_Nack); ok {
+		return x.Nack
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetListScheduled() *ListScheduled {
+	if x, ok := m.GetRequestData().(*CommandRequest_ListScheduled); ok {
+		return x.ListScheduled
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetCancelScheduled() *CancelScheduled {
+	if x, ok := m.GetRequestData().(*CommandRequest_CancelScheduled); ok {
+		return x.CancelScheduled
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetListTopics() *ListTopics {
+	if x, ok := m.GetRequestData().(*CommandRequest_ListTopics); ok {
+		return x.ListTopics
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetTopicInfo() *TopicInfo {
+	if x, ok := m.GetRequestData().(*CommandRequest_TopicInfo); ok {
+		return x.TopicInfo
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetListSubscriptions() *ListSubscriptions {
+	if x, ok := m.GetRequestData().(*CommandRequest_ListSubscriptions); ok {
+		return x.ListSubscriptions
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CommandRequest) GetDeadline() *timestamppb.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CommandRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CommandRequest_Hget)(nil),
+		(*CommandRequest_Hgetall)(nil),
+		(*CommandRequest_Hmget)(nil),
+		(*CommandRequest_Hset)(nil),
+		(*CommandRequest_Hmset)(nil),
+		(*CommandRequest_Hdel)(nil),
+		(*CommandRequest_Hmdel)(nil),
+		(*CommandRequest_Hexist)(nil),
+		(*CommandRequest_Hmexist)(nil),
+		(*CommandRequest_Subscribe)(nil),
+		(*CommandRequest_Unsubscribe)(nil),
+		(*CommandRequest_Publish)(nil),
+		(*CommandRequest_Negotiate)(nil),
+		(*CommandRequest_Hello)(nil),
+		(*CommandRequest_Ping)(nil),
+		(*CommandRequest_Echo)(nil),
+		(*CommandRequest_Info)(nil),
+		(*CommandRequest_Monitor)(nil),
+		(*CommandRequest_SlowlogGet)(nil),
+		(*CommandRequest_SlowlogReset)(nil),
+		(*CommandRequest_Watch)(nil),
+		(*CommandRequest_Psubscribe)(nil),
+		(*CommandRequest_Punsubscribe)(nil),
+		(*CommandRequest_ConfigureTopic)(nil),
+		(*CommandRequest_ConfigureGroup)(nil),
+		(*CommandRequest_Ack)(nil),
+		(*CommandRequest_Nack)(nil),
+		(*CommandRequest_ListScheduled)(nil),
+		(*CommandRequest_CancelScheduled)(nil),
+		(*CommandRequest_ListTopics)(nil),
+		(*CommandRequest_TopicInfo)(nil),
+		(*CommandRequest_ListSubscriptions)(nil),
+	}
+}
+
+// First command of a session. The server replies with the pairs `version`
+// (integer), `commands` (list of the request_data field names it supports),
+// `max_frame_size` (integer) and `server_id` (string)
+type Hello struct {
+	Version              uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ClientName           string   `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hello) Reset()         { *m = Hello{} }
+func (m *Hello) String() string { return proto.CompactTextString(m) }
+func (*Hello) ProtoMessage()    {}
+func (*Hello) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{1}
+}
+
+func (m *Hello) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hello.Unmarshal(m, b)
+}
+func (m *Hello) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hello.Marshal(b, m, deterministic)
+}
+func (m *Hello) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hello.Merge(m, src)
+}
+func (m *Hello) XXX_Size() int {
+	return xxx_messageInfo_Hello.Size(m)
+}
+func (m *Hello) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hello.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hello proto.InternalMessageInfo
+
+func (m *Hello) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Hello) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+// Agree on frame options for the session. The server replies with the
+// compressions it supports, as integer values in the client's order of
+// preference, and a `checksum` bool pair when it accepts CRC32C trailers.
+// The options apply from the frame following the reply
+type Negotiate struct {
+	Compressions         []Compression `protobuf:"varint,1,rep,packed,name=compressions,proto3,enum=abi.Compression" json:"compressions,omitempty"`
+	Checksum             bool          `protobuf:"varint,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Negotiate) Reset()         { *m = Negotiate{} }
+func (m *Negotiate) String() string { return proto.CompactTextString(m) }
+func (*Negotiate) ProtoMessage()    {}
+func (*Negotiate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{2}
+}
+
+func (m *Negotiate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Negotiate.Unmarshal(m, b)
+}
+func (m *Negotiate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Negotiate.Marshal(b, m, deterministic)
+}
+func (m *Negotiate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Negotiate.Merge(m, src)
+}
+func (m *Negotiate) XXX_Size() int {
+	return xxx_messageInfo_Negotiate.Size(m)
+}
+func (m *Negotiate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Negotiate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Negotiate proto.InternalMessageInfo
+
+func (m *Negotiate) GetCompressions() []Compression {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
+func (m *Negotiate) GetChecksum() bool {
+	if m != nil {
+		return m.Checksum
+	}
+	return false
+}
+
+// Health check, answered with an empty 200
+type Ping struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ping) Reset()         { *m = Ping{} }
+func (m *Ping) String() string { return proto.CompactTextString(m) }
+func (*Ping) ProtoMessage()    {}
+func (*Ping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{3}
+}
+
+func (m *Ping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ping.Unmarshal(m, b)
+}
+func (m *Ping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ping.Marshal(b, m, deterministic)
+}
+func (m *Ping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ping.Merge(m, src)
+}
+func (m *Ping) XXX_Size() int {
+	return xxx_messageInfo_Ping.Size(m)
+}
+func (m *Ping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ping proto.InternalMessageInfo
+
+// Answered with the same values
+type Echo struct {
+	Values               []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Echo) Reset()         { *m = Echo{} }
+func (m *Echo) String() string { return proto.CompactTextString(m) }
+func (*Echo) ProtoMessage()    {}
+func (*Echo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{4}
+}
+
+func (m *Echo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Echo.Unmarshal(m, b)
+}
+func (m *Echo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Echo.Marshal(b, m, deterministic)
+}
+func (m *Echo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Echo.Merge(m, src)
+}
+func (m *Echo) XXX_Size() int {
+	return xxx_messageInfo_Echo.Size(m)
+}
+func (m *Echo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Echo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Echo proto.InternalMessageInfo
+
+func (m *Echo) GetValues() []*Value {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// Server statistics, replied as pairs keyed `<section>.<name>`. Sections are
+// server (uptime, id), clients (connections, streams), commands (one map per
+// command with calls, errors and latency, the latter a map of bucket upper
+// bound in microseconds to count), memory (used bytes), keyspace (tables,
+// keys) and pubsub (topics, subscribers). An empty sections list returns them
+// all
+type Info struct {
+	Sections             []string `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Info) Reset()         { *m = Info{} }
+func (m *Info) String() string { return proto.CompactTextString(m) }
+func (*Info) ProtoMessage()    {}
+func (*Info) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{5}
+}
+
+func (m *Info) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Info.Unmarshal(m, b)
+}
+func (m *Info) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Info.Marshal(b, m, deterministic)
+}
+func (m *Info) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Info.Merge(m, src)
+}
+func (m *Info) XXX_Size() int {
+	return xxx_messageInfo_Info.Size(m)
+}
+func (m *Info) XXX_DiscardUnknown() {
+	xxx_messageInfo_Info.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Info proto.InternalMessageInfo
+
+func (m *Info) GetSections() []string {
+	if m != nil {
+		return m.Sections
+	}
+	return nil
+}
+
+// Streams every command the server executes, each as pairs time (timestamp),
+// client (string), table (string) and command (string). Empty filters match
+// everything. Requires the admin ACL, the server replies 403 otherwise
+type Monitor struct {
+	Tables               []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Commands             []string `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Monitor) Reset()         { *m = Monitor{} }
+func (m *Monitor) String() string { return proto.CompactTextString(m) }
+func (*Monitor) ProtoMessage()    {}
+func (*Monitor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{6}
+}
+
+func (m *Monitor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Monitor.Unmarshal(m, b)
+}
+func (m *Monitor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Monitor.Marshal(b, m, deterministic)
+}
+func (m *Monitor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Monitor.Merge(m, src)
+}
+func (m *Monitor) XXX_Size() int {
+	return xxx_messageInfo_Monitor.Size(m)
+}
+func (m *Monitor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Monitor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Monitor proto.InternalMessageInfo
+
+func (m *Monitor) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *Monitor) GetCommands() []string {
+	if m != nil {
+		return m.Commands
+	}
+	return nil
+}
+
+// Returns the latest count slow log entries, newest first, or every entry if
+// count is 0. Commands slower than the server threshold are kept in a bounded
+// ring buffer, each replied as a map value with id (integer), time
+// (timestamp), command, table, client (string), keys (integer) and duration
+type SlowlogGet struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlowlogGet) Reset()         { *m = SlowlogGet{} }
+func (m *SlowlogGet) String() string { return proto.CompactTextString(m) }
+func (*SlowlogGet) ProtoMessage()    {}
+func (*SlowlogGet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{7}
+}
+
+func (m *SlowlogGet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlowlogGet.Unmarshal(m, b)
+}
+func (m *SlowlogGet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlowlogGet.Marshal(b, m, deterministic)
+}
+func (m *SlowlogGet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlowlogGet.Merge(m, src)
+}
+func (m *SlowlogGet) XXX_Size() int {
+	return xxx_messageInfo_SlowlogGet.Size(m)
+}
+func (m *SlowlogGet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlowlogGet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlowlogGet proto.InternalMessageInfo
+
+func (m *SlowlogGet) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Empties the slow log
+type SlowlogReset struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlowlogReset) Reset()         { *m = SlowlogReset{} }
+func (m *SlowlogReset) String() string { return proto.CompactTextString(m) }
+func (*SlowlogReset) ProtoMessage()    {}
+func (*SlowlogReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{8}
+}
+
+func (m *SlowlogReset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlowlogReset.Unmarshal(m, b)
+}
+func (m *SlowlogReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlowlogReset.Marshal(b, m, deterministic)
+}
+func (m *SlowlogReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlowlogReset.Merge(m, src)
+}
+func (m *SlowlogReset) XXX_Size() int {
+	return xxx_messageInfo_SlowlogReset.Size(m)
+}
+func (m *SlowlogReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlowlogReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlowlogReset proto.InternalMessageInfo
+
+// Streams the changes of keys in table, each as pairs key (string), old and
+// new (values, null if absent) and version (integer). Versions increase with
+// every change of the table. A non-zero since_version first replays the
+// changes made after it, as far as the server still remembers them
+type Watch struct {
+	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	SinceVersion         uint64   `protobuf:"varint,3,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Watch) Reset()         { *m = Watch{} }
+func (m *Watch) String() string { return proto.CompactTextString(m) }
+func (*Watch) ProtoMessage()    {}
+func (*Watch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{9}
+}
+
+func (m *Watch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Watch.Unmarshal(m, b)
+}
+func (m *Watch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Watch.Marshal(b, m, deterministic)
+}
+func (m *Watch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Watch.Merge(m, src)
+}
+func (m *Watch) XXX_Size() int {
+	return xxx_messageInfo_Watch.Size(m)
+}
+func (m *Watch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Watch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Watch proto.InternalMessageInfo
+
+func (m *Watch) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *Watch) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Watch) GetSinceVersion() uint64 {
+	if m != nil {
+		return m.SinceVersion
+	}
+	return 0
+}
+
+// Topics prefixed with `__keyspace__:` followed by a table name are reserved.
+// When keyspace notifications are enabled the server publishes there, for
+// every key changed by hset, hmset, hdel, hmdel or expiry, a map value with
+// table, key and event (hset, hdel or expired) strings.
+// Closing the stream of a subscription unsubscribes it
+type Subscribe struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Where to start in the retained messages of the topic, offset is only
+	// used with OFFSET
+	Start  StartPosition `protobuf:"varint,2,opt,name=start,proto3,enum=abi.StartPosition" json:"start,omitempty"`
+	Offset uint64        `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Joins the named consumer group, each message of the topic then goes to a
+	// single member of the group, which must Ack or Nack it
+	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	// Asks for HEARTBEAT frames at this interval when no message is sent
+	Heartbeat *durationpb.Duration `protobuf:"bytes,5,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// Only messages matching the filter are delivered, the others are skipped
+	// by the server. An invalid filter is rejected with 400
+	Filter               *Filter  `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Subscribe) Reset()         { *m = Subscribe{} }
+func (m *Subscribe) String() string { return proto.CompactTextString(m) }
+func (*Subscribe) ProtoMessage()    {}
+func (*Subscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{10}
+}
+
+func (m *Subscribe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscribe.Unmarshal(m, b)
+}
+func (m *Subscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscribe.Marshal(b, m, deterministic)
+}
+func (m *Subscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscribe.Merge(m, src)
+}
+func (m *Subscribe) XXX_Size() int {
+	return xxx_messageInfo_Subscribe.Size(m)
+}
+func (m *Subscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscribe proto.InternalMessageInfo
+
+func (m *Subscribe) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Subscribe) GetStart() StartPosition {
+	if m != nil {
+		return m.Start
+	}
+	return StartPosition_LATEST
+}
+
+func (m *Subscribe) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Subscribe) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Subscribe) GetHeartbeat() *durationpb.Duration {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return nil
+}
+
+func (m *Subscribe) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// Predicate on the values of a published message
+type Filter struct {
+	// Types that are valid to be assigned to Expr:
+	//	*Filter_Condition
+	//	*Filter_All
+	//	*Filter_Any
+	//	*Filter_Not
+	Expr                 isFilter_Expr `protobuf_oneof:"expr"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Filter) Reset()         { *m = Filter{} }
+func (m *Filter) String() string { return proto.CompactTextString(m) }
+func (*Filter) ProtoMessage()    {}
+func (*Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{11}
+}
+
+func (m *Filter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Filter.Unmarshal(m, b)
+}
+func (m *Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Filter.Marshal(b, m, deterministic)
+}
+func (m *Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Filter.Merge(m, src)
+}
+func (m *Filter) XXX_Size() int {
+	return xxx_messageInfo_Filter.Size(m)
+}
+func (m *Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Filter proto.InternalMessageInfo
+
+type isFilter_Expr interface {
+	isFilter_Expr()
+}
+
+type Filter_Condition struct {
+	Condition *Condition `protobuf:"bytes,1,opt,name=condition,proto3,oneof"`
+}
+
+type Filter_All struct {
+	All *FilterList `protobuf:"bytes,2,opt,name=all,proto3,oneof"`
+}
+
+type Filter_Any struct {
+	Any *FilterList `protobuf:"bytes,3,opt,name=any,proto3,oneof"`
+}
+
+type Filter_Not struct {
+	Not *Filter `protobuf:"bytes,4,opt,name=not,proto3,oneof"`
+}
+
+func (*Filter_Condition) isFilter_Expr() {}
+
+func (*Filter_All) isFilter_Expr() {}
+
+func (*Filter_Any) isFilter_Expr() {}
+
+func (*Filter_Not) isFilter_Expr() {}
+
+func (m *Filter) GetExpr() isFilter_Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *Filter) GetCondition() *Condition {
+	if x, ok := m.GetExpr().(*Filter_Condition); ok {
+		return x.Condition
+	}
+	return nil
+}
+
+func (m *Filter) GetAll() *FilterList {
+	if x, ok := m.GetExpr().(*Filter_All); ok {
+		return x.All
+	}
+	return nil
+}
+
+func (m *Filter) GetAny() *FilterList {
+	if x, ok := m.GetExpr().(*Filter_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
+func (m *Filter) GetNot() *Filter {
+	if x, ok := m.GetExpr().(*Filter_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Filter) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Filter_Condition)(nil),
+		(*Filter_All)(nil),
+		(*Filter_Any)(nil),
+		(*Filter_Not)(nil),
+	}
+}
+
+type FilterList struct {
+	Filters              []*Filter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *FilterList) Reset()         { *m = FilterList{} }
+func (m *FilterList) String() string { return proto.CompactTextString(m) }
+func (*FilterList) ProtoMessage()    {}
+func (*FilterList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{12}
+}
+
+func (m *FilterList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterList.Unmarshal(m, b)
+}
+func (m *FilterList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterList.Marshal(b, m, deterministic)
+}
+func (m *FilterList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterList.Merge(m, src)
+}
+func (m *FilterList) XXX_Size() int {
+	return xxx_messageInfo_FilterList.Size(m)
+}
+func (m *FilterList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterList proto.InternalMessageInfo
+
+func (m *FilterList) GetFilters() []*Filter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// Compares the value at path with value. The path starts with the index of
+// a published value and goes down lists by index and maps by key, dot
+// separated, e.g. `0.tenant`. A path leading nowhere, or to a value of
+// another type, doesn't match
+type Condition struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Op                   Op       `protobuf:"varint,2,opt,name=op,proto3,enum=abi.Op" json:"op,omitempty"`
+	Value                *Value   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{13}
+}
+
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Condition.Unmarshal(m, b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return xxx_messageInfo_Condition.Size(m)
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
+func (m *Condition) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Condition) GetOp() Op {
+	if m != nil {
+		return m.Op
+	}
+	return Op_EQ
+}
+
+func (m *Condition) GetValue() *Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// Delivery settings of a consumer group. A message neither acked nor nacked
+// within visibility_timeout is redelivered to another member. Once delivered
+// max_deliveries times it is published on dead_letter_topic instead, or
+// dropped if there is none. Zero values keep the server defaults
+type ConfigureGroup struct {
+	Topic                string               `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Group                string               `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	VisibilityTimeout    *durationpb.Duration `protobuf:"bytes,3,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"`
+	MaxDeliveries        uint32               `protobuf:"varint,4,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"`
+	DeadLetterTopic      string               `protobuf:"bytes,5,opt,name=dead_letter_topic,json=deadLetterTopic,proto3" json:"dead_letter_topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ConfigureGroup) Reset()         { *m = ConfigureGroup{} }
+func (m *ConfigureGroup) String() string { return proto.CompactTextString(m) }
+func (*ConfigureGroup) ProtoMessage()    {}
+func (*ConfigureGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{14}
+}
+
+func (m *ConfigureGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureGroup.Unmarshal(m, b)
+}
+func (m *ConfigureGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureGroup.Marshal(b, m, deterministic)
+}
+func (m *ConfigureGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureGroup.Merge(m, src)
+}
+func (m *ConfigureGroup) XXX_Size() int {
+	return xxx_messageInfo_ConfigureGroup.Size(m)
+}
+func (m *ConfigureGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureGroup proto.InternalMessageInfo
+
+func (m *ConfigureGroup) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ConfigureGroup) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ConfigureGroup) GetVisibilityTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.VisibilityTimeout
+	}
+	return nil
+}
+
+func (m *ConfigureGroup) GetMaxDeliveries() uint32 {
+	if m != nil {
+		return m.MaxDeliveries
+	}
+	return 0
+}
+
+func (m *ConfigureGroup) GetDeadLetterTopic() string {
+	if m != nil {
+		return m.DeadLetterTopic
+	}
+	return ""
+}
+
+// Marks the messages of a consumer group as processed
+type Ack struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Ids                  []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ack) Reset()         { *m = Ack{} }
+func (m *Ack) String() string { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()    {}
+func (*Ack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{15}
+}
+
+func (m *Ack) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ack.Unmarshal(m, b)
+}
+func (m *Ack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ack.Marshal(b, m, deterministic)
+}
+func (m *Ack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ack.Merge(m, src)
+}
+func (m *Ack) XXX_Size() int {
+	return xxx_messageInfo_Ack.Size(m)
+}
+func (m *Ack) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ack proto.InternalMessageInfo
+
+func (m *Ack) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Ack) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Ack) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// Hands the messages of a consumer group back for immediate redelivery
+type Nack struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Ids                  []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Nack) Reset()         { *m = Nack{} }
+func (m *Nack) String() string { return proto.CompactTextString(m) }
+func (*Nack) ProtoMessage()    {}
+func (*Nack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{16}
+}
+
+func (m *Nack) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Nack.Unmarshal(m, b)
+}
+func (m *Nack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Nack.Marshal(b, m, deterministic)
+}
+func (m *Nack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nack.Merge(m, src)
+}
+func (m *Nack) XXX_Size() int {
+	return xxx_messageInfo_Nack.Size(m)
+}
+func (m *Nack) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nack proto.InternalMessageInfo
+
+func (m *Nack) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Nack) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Nack) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// Sets the retention of a topic, messages beyond any non-zero limit are
+// dropped oldest first. Topics without retention keep nothing, so only
+// subscribers connected when a message is published receive it
+type ConfigureTopic struct {
+	Topic                string               `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	MaxMessages          uint64               `protobuf:"varint,2,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	MaxAge               *durationpb.Duration `protobuf:"bytes,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	MaxBytes             uint64               `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ConfigureTopic) Reset()         { *m = ConfigureTopic{} }
+func (m *ConfigureTopic) String() string { return proto.CompactTextString(m) }
+func (*ConfigureTopic) ProtoMessage()    {}
+func (*ConfigureTopic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{17}
+}
+
+func (m *ConfigureTopic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureTopic.Unmarshal(m, b)
+}
+func (m *ConfigureTopic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureTopic.Marshal(b, m, deterministic)
+}
+func (m *ConfigureTopic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureTopic.Merge(m, src)
+}
+func (m *ConfigureTopic) XXX_Size() int {
+	return xxx_messageInfo_ConfigureTopic.Size(m)
+}
+func (m *ConfigureTopic) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureTopic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureTopic proto.InternalMessageInfo
+
+func (m *ConfigureTopic) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ConfigureTopic) GetMaxMessages() uint64 {
+	if m != nil {
+		return m.MaxMessages
+	}
+	return 0
+}
+
+func (m *ConfigureTopic) GetMaxAge() *durationpb.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+func (m *ConfigureTopic) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type Unsubscribe struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Id                   uint32   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Unsubscribe) Reset()         { *m = Unsubscribe{} }
+func (m *Unsubscribe) String() string { return proto.CompactTextString(m) }
+func (*Unsubscribe) ProtoMessage()    {}
+func (*Unsubscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{18}
+}
+
+func (m *Unsubscribe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Unsubscribe.Unmarshal(m, b)
+}
+func (m *Unsubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Unsubscribe.Marshal(b, m, deterministic)
+}
+func (m *Unsubscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unsubscribe.Merge(m, src)
+}
+func (m *Unsubscribe) XXX_Size() int {
+	return xxx_messageInfo_Unsubscribe.Size(m)
+}
+func (m *Unsubscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unsubscribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unsubscribe proto.InternalMessageInfo
+
+func (m *Unsubscribe) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Unsubscribe) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Subscribes to every topic matching the glob pattern, e.g. `orders.*`, with
+// the syntax of Go's path.Match. Messages carry their concrete topic
+type Psubscribe struct {
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Asks for HEARTBEAT frames at this interval when no message is sent, see
+	// Subscribe
+	Heartbeat            *durationpb.Duration `protobuf:"bytes,2,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Psubscribe) Reset()         { *m = Psubscribe{} }
+func (m *Psubscribe) String() string { return proto.CompactTextString(m) }
+func (*Psubscribe) ProtoMessage()    {}
+func (*Psubscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{19}
+}
+
+func (m *Psubscribe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Psubscribe.Unmarshal(m, b)
+}
+func (m *Psubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Psubscribe.Marshal(b, m, deterministic)
+}
+func (m *Psubscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Psubscribe.Merge(m, src)
+}
+func (m *Psubscribe) XXX_Size() int {
+	return xxx_messageInfo_Psubscribe.Size(m)
+}
+func (m *Psubscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Psubscribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Psubscribe proto.InternalMessageInfo
+
+func (m *Psubscribe) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *Psubscribe) GetHeartbeat() *durationpb.Duration {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return nil
+}
+
+type Punsubscribe struct {
+	Pattern              string   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Id                   uint32   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Punsubscribe) Reset()         { *m = Punsubscribe{} }
+func (m *Punsubscribe) String() string { return proto.CompactTextString(m) }
+func (*Punsubscribe) ProtoMessage()    {}
+func (*Punsubscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{20}
+}
+
+func (m *Punsubscribe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Punsubscribe.Unmarshal(m, b)
+}
+func (m *Punsubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Punsubscribe.Marshal(b, m, deterministic)
+}
+func (m *Punsubscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Punsubscribe.Merge(m, src)
+}
+func (m *Punsubscribe) XXX_Size() int {
+	return xxx_messageInfo_Punsubscribe.Size(m)
+}
+func (m *Punsubscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Punsubscribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Punsubscribe proto.InternalMessageInfo
+
+func (m *Punsubscribe) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *Punsubscribe) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Replied with the pairs id and offset of the message, delivered, the number
+// of subscribers it was sent to, and acks (integers)
+type Publish struct {
+	Topic string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  []*Value `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// Delays the reply until wait_acks consumer group members acknowledged the
+	// message or wait_timeout passed, acks tells which
+	WaitAcks    uint32               `protobuf:"varint,3,opt,name=wait_acks,json=waitAcks,proto3" json:"wait_acks,omitempty"`
+	WaitTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	// Holds the message until deliver_at, or for delay once received. The
+	// reply id is then the schedule id, the message gets its own id and offset
+	// when released
+	DeliverAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deliver_at,json=deliverAt,proto3" json:"deliver_at,omitempty"`
+	Delay                *durationpb.Duration   `protobuf:"bytes,6,opt,name=delay,proto3" json:"delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Publish) Reset()         { *m = Publish{} }
+func (m *Publish) String() string { return proto.CompactTextString(m) }
+func (*Publish) ProtoMessage()    {}
+func (*Publish) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{21}
+}
+
+func (m *Publish) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Publish.Unmarshal(m, b)
+}
+func (m *Publish) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Publish.Marshal(b, m, deterministic)
+}
+func (m *Publish) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Publish.Merge(m, src)
+}
+func (m *Publish) XXX_Size() int {
+	return xxx_messageInfo_Publish.Size(m)
+}
+func (m *Publish) XXX_DiscardUnknown() {
+	xxx_messageInfo_Publish.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Publish proto.InternalMessageInfo
+
+func (m *Publish) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Publish) GetData() []*Value {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Publish) GetWaitAcks() uint32 {
+	if m != nil {
+		return m.WaitAcks
+	}
+	return 0
+}
+
+func (m *Publish) GetWaitTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return nil
+}
+
+func (m *Publish) GetDeliverAt() *timestamppb.Timestamp {
+	if m != nil {
+		return m.DeliverAt
+	}
+	return nil
+}
+
+func (m *Publish) GetDelay() *durationpb.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return nil
+}
+
+// Returns the messages scheduled on topic, or on every topic if empty, as map
+// values with id (integer), topic (string), deliver_at (timestamp) and
+// payload (list), soonest first
+type ListScheduled struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListScheduled) Reset()         { *m = ListScheduled{} }
+func (m *ListScheduled) String() string { return proto.CompactTextString(m) }
+func (*ListScheduled) ProtoMessage()    {}
+func (*ListScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{22}
+}
+
+func (m *ListScheduled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListScheduled.Unmarshal(m, b)
+}
+func (m *ListScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListScheduled.Marshal(b, m, deterministic)
+}
+func (m *ListScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListScheduled.Merge(m, src)
+}
+func (m *ListScheduled) XXX_Size() int {
+	return xxx_messageInfo_ListScheduled.Size(m)
+}
+func (m *ListScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListScheduled proto.InternalMessageInfo
+
+func (m *ListScheduled) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+// Returns the names of the topics matching the glob pattern, all of them if
+// empty, as sorted string values
+type ListTopics struct {
+	Pattern              string   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTopics) Reset()         { *m = ListTopics{} }
+func (m *ListTopics) String() string { return proto.CompactTextString(m) }
+func (*ListTopics) ProtoMessage()    {}
+func (*ListTopics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{23}
+}
+
+func (m *ListTopics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTopics.Unmarshal(m, b)
+}
+func (m *ListTopics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTopics.Marshal(b, m, deterministic)
+}
+func (m *ListTopics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopics.Merge(m, src)
+}
+func (m *ListTopics) XXX_Size() int {
+	return xxx_messageInfo_ListTopics.Size(m)
+}
+func (m *ListTopics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopics.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CommandRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CommandRequest_Hget)(nil),
-		(*CommandRequest_Hgetall)(nil),
-		(*CommandRequest_Hmget)(nil),
-		(*CommandRequest_Hset)(nil),
-		(*CommandRequest_Hmset)(nil),
-		(*CommandRequest_Hdel)(nil),
-		(*CommandRequest_Hmdel)(nil),
-		(*CommandRequest_Hexist)(nil),
-		(*CommandRequest_Hmexist)(nil),
-		(*CommandRequest_Subscribe)(nil),
-		(*CommandRequest_Unsubscribe)(nil),
-		(*CommandRequest_Publish)(nil),
+var xxx_messageInfo_ListTopics proto.InternalMessageInfo
+
+func (m *ListTopics) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
+	return ""
 }
 
-type Subscribe struct {
+// Replied with the pairs subscribers, retained_messages, retained_bytes
+// (integers) and publish_rate (float, messages per second over the last
+// minute), 404 if the topic doesn't exist
+type TopicInfo struct {
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Subscribe) Reset()         { *m = Subscribe{} }
-func (m *Subscribe) String() string { return proto.CompactTextString(m) }
-func (*Subscribe) ProtoMessage()    {}
-func (*Subscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{1}
+func (m *TopicInfo) Reset()         { *m = TopicInfo{} }
+func (m *TopicInfo) String() string { return proto.CompactTextString(m) }
+func (*TopicInfo) ProtoMessage()    {}
+func (*TopicInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{24}
 }
 
-func (m *Subscribe) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Subscribe.Unmarshal(m, b)
+func (m *TopicInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopicInfo.Unmarshal(m, b)
 }
-func (m *Subscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Subscribe.Marshal(b, m, deterministic)
+func (m *TopicInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopicInfo.Marshal(b, m, deterministic)
 }
-func (m *Subscribe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Subscribe.Merge(m, src)
+func (m *TopicInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicInfo.Merge(m, src)
 }
-func (m *Subscribe) XXX_Size() int {
-	return xxx_messageInfo_Subscribe.Size(m)
+func (m *TopicInfo) XXX_Size() int {
+	return xxx_messageInfo_TopicInfo.Size(m)
 }
-func (m *Subscribe) XXX_DiscardUnknown() {
-	xxx_messageInfo_Subscribe.DiscardUnknown(m)
+func (m *TopicInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Subscribe proto.InternalMessageInfo
+var xxx_messageInfo_TopicInfo proto.InternalMessageInfo
 
-func (m *Subscribe) GetTopic() string {
+func (m *TopicInfo) GetTopic() string {
 	if m != nil {
 		return m.Topic
 	}
 	return ""
 }
 
-type Unsubscribe struct {
+// Returns the subscriptions to topic, or to every topic if empty, as map
+// values with id (integer), topic, client, group (strings) and lag, the
+// number of messages published but not yet delivered (integer)
+type ListSubscriptions struct {
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Id                   uint32   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Unsubscribe) Reset()         { *m = Unsubscribe{} }
-func (m *Unsubscribe) String() string { return proto.CompactTextString(m) }
-func (*Unsubscribe) ProtoMessage()    {}
-func (*Unsubscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{2}
+func (m *ListSubscriptions) Reset()         { *m = ListSubscriptions{} }
+func (m *ListSubscriptions) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptions) ProtoMessage()    {}
+func (*ListSubscriptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{25}
 }
 
-func (m *Unsubscribe) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Unsubscribe.Unmarshal(m, b)
+func (m *ListSubscriptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptions.Unmarshal(m, b)
 }
-func (m *Unsubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Unsubscribe.Marshal(b, m, deterministic)
+func (m *ListSubscriptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptions.Marshal(b, m, deterministic)
 }
-func (m *Unsubscribe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Unsubscribe.Merge(m, src)
+func (m *ListSubscriptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptions.Merge(m, src)
 }
-func (m *Unsubscribe) XXX_Size() int {
-	return xxx_messageInfo_Unsubscribe.Size(m)
+func (m *ListSubscriptions) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptions.Size(m)
 }
-func (m *Unsubscribe) XXX_DiscardUnknown() {
-	xxx_messageInfo_Unsubscribe.DiscardUnknown(m)
+func (m *ListSubscriptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Unsubscribe proto.InternalMessageInfo
+var xxx_messageInfo_ListSubscriptions proto.InternalMessageInfo
 
-func (m *Unsubscribe) GetTopic() string {
+func (m *ListSubscriptions) GetTopic() string {
 	if m != nil {
 		return m.Topic
 	}
 	return ""
 }
 
-func (m *Unsubscribe) GetId() uint32 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-type Publish struct {
+// Drops a scheduled message, 404 if it was already released
+type CancelScheduled struct {
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Data                 []*Value `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Id                   uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Publish) Reset()         { *m = Publish{} }
-func (m *Publish) String() string { return proto.CompactTextString(m) }
-func (*Publish) ProtoMessage()    {}
-func (*Publish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{3}
+func (m *CancelScheduled) Reset()         { *m = CancelScheduled{} }
+func (m *CancelScheduled) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduled) ProtoMessage()    {}
+func (*CancelScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{26}
 }
 
-func (m *Publish) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Publish.Unmarshal(m, b)
+func (m *CancelScheduled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelScheduled.Unmarshal(m, b)
 }
-func (m *Publish) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Publish.Marshal(b, m, deterministic)
+func (m *CancelScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelScheduled.Marshal(b, m, deterministic)
 }
-func (m *Publish) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Publish.Merge(m, src)
+func (m *CancelScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduled.Merge(m, src)
 }
-func (m *Publish) XXX_Size() int {
-	return xxx_messageInfo_Publish.Size(m)
+func (m *CancelScheduled) XXX_Size() int {
+	return xxx_messageInfo_CancelScheduled.Size(m)
 }
-func (m *Publish) XXX_DiscardUnknown() {
-	xxx_messageInfo_Publish.DiscardUnknown(m)
+func (m *CancelScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Publish proto.InternalMessageInfo
+var xxx_messageInfo_CancelScheduled proto.InternalMessageInfo
 
-func (m *Publish) GetTopic() string {
+func (m *CancelScheduled) GetTopic() string {
 	if m != nil {
 		return m.Topic
 	}
 	return ""
 }
 
-func (m *Publish) GetData() []*Value {
+func (m *CancelScheduled) GetId() uint64 {
 	if m != nil {
-		return m.Data
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
 // Server response
@@ -393,17 +2179,29 @@ type CommandResponse struct {
 	// Values
 	Values []*Value `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 	// Kvpair
-	Pairs                []*Kvpair `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pairs []*Kvpair `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// Metadata and deadline of the request, echoed
+	Metadata map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Topic a streamed message was published on, set by subscriptions
+	Topic string `protobuf:"bytes,7,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Envelope of a message delivered to a subscription, its payload is also
+	// in values
+	Envelope *Message `protobuf:"bytes,8,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	// Kind of frame on a stream. END frames keep status 0, which is how older
+	// servers end streams, and say why in end_reason
+	Frame                StreamFrame `protobuf:"varint,9,opt,name=frame,proto3,enum=abi.StreamFrame" json:"frame,omitempty"`
+	EndReason            EndReason   `protobuf:"varint,10,opt,name=end_reason,json=endReason,proto3,enum=abi.EndReason" json:"end_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *CommandResponse) Reset()         { *m = CommandResponse{} }
 func (m *CommandResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandResponse) ProtoMessage()    {}
 func (*CommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{4}
+	return fileDescriptor_9f13b6186784fe43, []int{27}
 }
 
 func (m *CommandResponse) XXX_Unmarshal(b []byte) error {
@@ -452,6 +2250,141 @@ func (m *CommandResponse) GetPairs() []*Kvpair {
 	return nil
 }
 
+func (m *CommandResponse) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CommandResponse) GetDeadline() *timestamppb.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *CommandResponse) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *CommandResponse) GetEnvelope() *Message {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (m *CommandResponse) GetFrame() StreamFrame {
+	if m != nil {
+		return m.Frame
+	}
+	return StreamFrame_DATA
+}
+
+func (m *CommandResponse) GetEndReason() EndReason {
+	if m != nil {
+		return m.EndReason
+	}
+	return EndReason_UNKNOWN
+}
+
+// Published data as delivered to subscribers. Ids increase with every message
+// published on the topic
+type Message struct {
+	Topic       string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Id          uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Identity of the publishing client
+	Publisher string   `protobuf:"bytes,4,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Payload   []*Value `protobuf:"bytes,5,rep,name=payload,proto3" json:"payload,omitempty"`
+	// Position in the topic, set when the topic retains messages. Offsets
+	// increase monotonically and are never reused
+	Offset uint64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Delivery attempt, from 1, for consumer groups
+	Delivery             uint32   `protobuf:"varint,7,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Message) Reset()         { *m = Message{} }
+func (m *Message) String() string { return proto.CompactTextString(m) }
+func (*Message) ProtoMessage()    {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{28}
+}
+
+func (m *Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Message.Unmarshal(m, b)
+}
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+}
+func (m *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(m, src)
+}
+func (m *Message) XXX_Size() int {
+	return xxx_messageInfo_Message.Size(m)
+}
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Message proto.InternalMessageInfo
+
+func (m *Message) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Message) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Message) GetPublishedAt() *timestamppb.Timestamp {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return nil
+}
+
+func (m *Message) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *Message) GetPayload() []*Value {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Message) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Message) GetDelivery() uint32 {
+	if m != nil {
+		return m.Delivery
+	}
+	return 0
+}
+
 type Hget struct {
 	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
 	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -464,7 +2397,7 @@ func (m *Hget) Reset()         { *m = Hget{} }
 func (m *Hget) String() string { return proto.CompactTextString(m) }
 func (*Hget) ProtoMessage()    {}
 func (*Hget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{5}
+	return fileDescriptor_9f13b6186784fe43, []int{29}
 }
 
 func (m *Hget) XXX_Unmarshal(b []byte) error {
@@ -510,7 +2443,7 @@ func (m *Hgetall) Reset()         { *m = Hgetall{} }
 func (m *Hgetall) String() string { return proto.CompactTextString(m) }
 func (*Hgetall) ProtoMessage()    {}
 func (*Hgetall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{6}
+	return fileDescriptor_9f13b6186784fe43, []int{30}
 }
 
 func (m *Hgetall) XXX_Unmarshal(b []byte) error {
@@ -550,7 +2483,7 @@ func (m *Hmget) Reset()         { *m = Hmget{} }
 func (m *Hmget) String() string { return proto.CompactTextString(m) }
 func (*Hmget) ProtoMessage()    {}
 func (*Hmget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{7}
+	return fileDescriptor_9f13b6186784fe43, []int{31}
 }
 
 func (m *Hmget) XXX_Unmarshal(b []byte) error {
@@ -592,6 +2525,11 @@ type Value struct {
 	//	*Value_Integer
 	//	*Value_Float
 	//	*Value_Bool
+	//	*Value_Timestamp
+	//	*Value_Duration
+	//	*Value_List
+	//	*Value_Map
+	//	*Value_Null
 	Value                isValue_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -602,7 +2540,7 @@ func (m *Value) Reset()         { *m = Value{} }
 func (m *Value) String() string { return proto.CompactTextString(m) }
 func (*Value) ProtoMessage()    {}
 func (*Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{8}
+	return fileDescriptor_9f13b6186784fe43, []int{32}
 }
 
 func (m *Value) XXX_Unmarshal(b []byte) error {
@@ -647,6 +2585,26 @@ type Value_Bool struct {
 	Bool bool `protobuf:"varint,5,opt,name=bool,proto3,oneof"`
 }
 
+type Value_Timestamp struct {
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3,oneof"`
+}
+
+type Value_Duration struct {
+	Duration *durationpb.Duration `protobuf:"bytes,7,opt,name=duration,proto3,oneof"`
+}
+
+type Value_List struct {
+	List *ValueList `protobuf:"bytes,8,opt,name=list,proto3,oneof"`
+}
+
+type Value_Map struct {
+	Map *ValueMap `protobuf:"bytes,9,opt,name=map,proto3,oneof"`
+}
+
+type Value_Null struct {
+	Null Null `protobuf:"varint,10,opt,name=null,proto3,enum=abi.Null,oneof"`
+}
+
 func (*Value_String_) isValue_Value() {}
 
 func (*Value_Binary) isValue_Value() {}
@@ -657,6 +2615,16 @@ func (*Value_Float) isValue_Value() {}
 
 func (*Value_Bool) isValue_Value() {}
 
+func (*Value_Timestamp) isValue_Value() {}
+
+func (*Value_Duration) isValue_Value() {}
+
+func (*Value_List) isValue_Value() {}
+
+func (*Value_Map) isValue_Value() {}
+
+func (*Value_Null) isValue_Value() {}
+
 func (m *Value) GetValue() isValue_Value {
 	if m != nil {
 		return m.Value
@@ -699,6 +2667,41 @@ func (m *Value) GetBool() bool {
 	return false
 }
 
+func (m *Value) GetTimestamp() *timestamppb.Timestamp {
+	if x, ok := m.GetValue().(*Value_Timestamp); ok {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (m *Value) GetDuration() *durationpb.Duration {
+	if x, ok := m.GetValue().(*Value_Duration); ok {
+		return x.Duration
+	}
+	return nil
+}
+
+func (m *Value) GetList() *ValueList {
+	if x, ok := m.GetValue().(*Value_List); ok {
+		return x.List
+	}
+	return nil
+}
+
+func (m *Value) GetMap() *ValueMap {
+	if x, ok := m.GetValue().(*Value_Map); ok {
+		return x.Map
+	}
+	return nil
+}
+
+func (m *Value) GetNull() Null {
+	if x, ok := m.GetValue().(*Value_Null); ok {
+		return x.Null
+	}
+	return Null_NULL_VALUE
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Value) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -707,7 +2710,90 @@ func (*Value) XXX_OneofWrappers() []interface{} {
 		(*Value_Integer)(nil),
 		(*Value_Float)(nil),
 		(*Value_Bool)(nil),
+		(*Value_Timestamp)(nil),
+		(*Value_Duration)(nil),
+		(*Value_List)(nil),
+		(*Value_Map)(nil),
+		(*Value_Null)(nil),
+	}
+}
+
+type ValueList struct {
+	Values               []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValueList) Reset()         { *m = ValueList{} }
+func (m *ValueList) String() string { return proto.CompactTextString(m) }
+func (*ValueList) ProtoMessage()    {}
+func (*ValueList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{33}
+}
+
+func (m *ValueList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueList.Unmarshal(m, b)
+}
+func (m *ValueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueList.Marshal(b, m, deterministic)
+}
+func (m *ValueList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueList.Merge(m, src)
+}
+func (m *ValueList) XXX_Size() int {
+	return xxx_messageInfo_ValueList.Size(m)
+}
+func (m *ValueList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueList proto.InternalMessageInfo
+
+func (m *ValueList) GetValues() []*Value {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type ValueMap struct {
+	Values               map[string]*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueMap) Reset()         { *m = ValueMap{} }
+func (m *ValueMap) String() string { return proto.CompactTextString(m) }
+func (*ValueMap) ProtoMessage()    {}
+func (*ValueMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f13b6186784fe43, []int{34}
+}
+
+func (m *ValueMap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueMap.Unmarshal(m, b)
+}
+func (m *ValueMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueMap.Marshal(b, m, deterministic)
+}
+func (m *ValueMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueMap.Merge(m, src)
+}
+func (m *ValueMap) XXX_Size() int {
+	return xxx_messageInfo_ValueMap.Size(m)
+}
+func (m *ValueMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueMap proto.InternalMessageInfo
+
+func (m *ValueMap) GetValues() map[string]*Value {
+	if m != nil {
+		return m.Values
 	}
+	return nil
 }
 
 type Kvpair struct {
@@ -722,7 +2808,7 @@ func (m *Kvpair) Reset()         { *m = Kvpair{} }
 func (m *Kvpair) String() string { return proto.CompactTextString(m) }
 func (*Kvpair) ProtoMessage()    {}
 func (*Kvpair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{9}
+	return fileDescriptor_9f13b6186784fe43, []int{35}
 }
 
 func (m *Kvpair) XXX_Unmarshal(b []byte) error {
@@ -769,7 +2855,7 @@ func (m *Hset) Reset()         { *m = Hset{} }
 func (m *Hset) String() string { return proto.CompactTextString(m) }
 func (*Hset) ProtoMessage()    {}
 func (*Hset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{10}
+	return fileDescriptor_9f13b6186784fe43, []int{36}
 }
 
 func (m *Hset) XXX_Unmarshal(b []byte) error {
@@ -816,7 +2902,7 @@ func (m *Hmset) Reset()         { *m = Hmset{} }
 func (m *Hmset) String() string { return proto.CompactTextString(m) }
 func (*Hmset) ProtoMessage()    {}
 func (*Hmset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{11}
+	return fileDescriptor_9f13b6186784fe43, []int{37}
 }
 
 func (m *Hmset) XXX_Unmarshal(b []byte) error {
@@ -863,7 +2949,7 @@ func (m *Hdel) Reset()         { *m = Hdel{} }
 func (m *Hdel) String() string { return proto.CompactTextString(m) }
 func (*Hdel) ProtoMessage()    {}
 func (*Hdel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{12}
+	return fileDescriptor_9f13b6186784fe43, []int{38}
 }
 
 func (m *Hdel) XXX_Unmarshal(b []byte) error {
@@ -910,7 +2996,7 @@ func (m *Hmdel) Reset()         { *m = Hmdel{} }
 func (m *Hmdel) String() string { return proto.CompactTextString(m) }
 func (*Hmdel) ProtoMessage()    {}
 func (*Hmdel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{13}
+	return fileDescriptor_9f13b6186784fe43, []int{39}
 }
 
 func (m *Hmdel) XXX_Unmarshal(b []byte) error {
@@ -957,7 +3043,7 @@ func (m *Hexist) Reset()         { *m = Hexist{} }
 func (m *Hexist) String() string { return proto.CompactTextString(m) }
 func (*Hexist) ProtoMessage()    {}
 func (*Hexist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{14}
+	return fileDescriptor_9f13b6186784fe43, []int{40}
 }
 
 func (m *Hexist) XXX_Unmarshal(b []byte) error {
@@ -1004,7 +3090,7 @@ func (m *Hmexist) Reset()         { *m = Hmexist{} }
 func (m *Hmexist) String() string { return proto.CompactTextString(m) }
 func (*Hmexist) ProtoMessage()    {}
 func (*Hmexist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f13b6186784fe43, []int{15}
+	return fileDescriptor_9f13b6186784fe43, []int{41}
 }
 
 func (m *Hmexist) XXX_Unmarshal(b []byte) error {
@@ -1040,15 +3126,50 @@ func (m *Hmexist) GetKeys() []string {
 }
 
 func init() {
+	proto.RegisterEnum("abi.Compression", Compression_name, Compression_value)
+	proto.RegisterEnum("abi.Op", Op_name, Op_value)
+	proto.RegisterEnum("abi.StartPosition", StartPosition_name, StartPosition_value)
+	proto.RegisterEnum("abi.StreamFrame", StreamFrame_name, StreamFrame_value)
+	proto.RegisterEnum("abi.EndReason", EndReason_name, EndReason_value)
+	proto.RegisterEnum("abi.Null", Null_name, Null_value)
 	proto.RegisterType((*CommandRequest)(nil), "abi.CommandRequest")
+	proto.RegisterMapType((map[string]string)(nil), "abi.CommandRequest.MetadataEntry")
+	proto.RegisterType((*Hello)(nil), "abi.Hello")
+	proto.RegisterType((*Negotiate)(nil), "abi.Negotiate")
+	proto.RegisterType((*Ping)(nil), "abi.Ping")
+	proto.RegisterType((*Echo)(nil), "abi.Echo")
+	proto.RegisterType((*Info)(nil), "abi.Info")
+	proto.RegisterType((*Monitor)(nil), "abi.Monitor")
+	proto.RegisterType((*SlowlogGet)(nil), "abi.SlowlogGet")
+	proto.RegisterType((*SlowlogReset)(nil), "abi.SlowlogReset")
+	proto.RegisterType((*Watch)(nil), "abi.Watch")
 	proto.RegisterType((*Subscribe)(nil), "abi.Subscribe")
+	proto.RegisterType((*Filter)(nil), "abi.Filter")
+	proto.RegisterType((*FilterList)(nil), "abi.FilterList")
+	proto.RegisterType((*Condition)(nil), "abi.Condition")
+	proto.RegisterType((*ConfigureGroup)(nil), "abi.ConfigureGroup")
+	proto.RegisterType((*Ack)(nil), "abi.Ack")
+	proto.RegisterType((*Nack)(nil), "abi.Nack")
+	proto.RegisterType((*ConfigureTopic)(nil), "abi.ConfigureTopic")
 	proto.RegisterType((*Unsubscribe)(nil), "abi.Unsubscribe")
+	proto.RegisterType((*Psubscribe)(nil), "abi.Psubscribe")
+	proto.RegisterType((*Punsubscribe)(nil), "abi.Punsubscribe")
 	proto.RegisterType((*Publish)(nil), "abi.Publish")
+	proto.RegisterType((*ListScheduled)(nil), "abi.ListScheduled")
+	proto.RegisterType((*ListTopics)(nil), "abi.ListTopics")
+	proto.RegisterType((*TopicInfo)(nil), "abi.TopicInfo")
+	proto.RegisterType((*ListSubscriptions)(nil), "abi.ListSubscriptions")
+	proto.RegisterType((*CancelScheduled)(nil), "abi.CancelScheduled")
 	proto.RegisterType((*CommandResponse)(nil), "abi.CommandResponse")
+	proto.RegisterMapType((map[string]string)(nil), "abi.CommandResponse.MetadataEntry")
+	proto.RegisterType((*Message)(nil), "abi.Message")
 	proto.RegisterType((*Hget)(nil), "abi.Hget")
 	proto.RegisterType((*Hgetall)(nil), "abi.Hgetall")
 	proto.RegisterType((*Hmget)(nil), "abi.Hmget")
 	proto.RegisterType((*Value)(nil), "abi.Value")
+	proto.RegisterType((*ValueList)(nil), "abi.ValueList")
+	proto.RegisterType((*ValueMap)(nil), "abi.ValueMap")
+	proto.RegisterMapType((map[string]*Value)(nil), "abi.ValueMap.ValuesEntry")
 	proto.RegisterType((*Kvpair)(nil), "abi.Kvpair")
 	proto.RegisterType((*Hset)(nil), "abi.Hset")
 	proto.RegisterType((*Hmset)(nil), "abi.Hmset")
@@ -1061,44 +3182,152 @@ func init() {
 func init() { proto.RegisterFile("abi.proto", fileDescriptor_9f13b6186784fe43) }
 
 var fileDescriptor_9f13b6186784fe43 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x4d, 0xe2, 0xaf, 0x7a, 0x92, 0x86, 0x6a, 0x55, 0x55, 0x16, 0x07, 0xda, 0xae, 0x84, 0xd4,
-	0x53, 0x04, 0x94, 0x23, 0x08, 0x54, 0x2e, 0x91, 0xb8, 0xa0, 0x45, 0x70, 0x45, 0xeb, 0x7a, 0x49,
-	0x56, 0xb5, 0xe3, 0xe0, 0x5d, 0x57, 0xf4, 0x2f, 0x70, 0xe0, 0x07, 0x73, 0x42, 0x33, 0xbb, 0x76,
-	0x5d, 0xd1, 0xa0, 0x9c, 0xec, 0x99, 0xf7, 0xe6, 0xcd, 0xec, 0xcc, 0xce, 0x42, 0x2a, 0x73, 0xbd,
-	0xd8, 0x36, 0xb5, 0xad, 0x59, 0x20, 0x73, 0xcd, 0xff, 0x04, 0x30, 0xff, 0x50, 0x57, 0x95, 0xdc,
-	0x14, 0x42, 0xfd, 0x68, 0x95, 0xb1, 0xec, 0x14, 0xc2, 0xf5, 0x4a, 0xd9, 0x6c, 0x7c, 0x36, 0xbe,
-	0x98, 0xbe, 0x4a, 0x17, 0x18, 0xb1, 0x5c, 0x29, 0xbb, 0x1c, 0x09, 0x02, 0xd8, 0x05, 0x24, 0xf8,
-	0x95, 0x65, 0x99, 0x4d, 0x88, 0x33, 0xeb, 0x39, 0xb2, 0x2c, 0x97, 0x23, 0xd1, 0xc1, 0x8c, 0x43,
-	0xb4, 0xae, 0x50, 0x2b, 0x20, 0x1e, 0x38, 0x5e, 0xe5, 0xc4, 0x1c, 0x44, 0xe9, 0x8c, 0xb2, 0x59,
-	0x38, 0x4c, 0x67, 0x7c, 0x3a, 0xa3, 0xac, 0x13, 0x41, 0x46, 0xf4, 0x40, 0xc4, 0x74, 0x22, 0xc6,
-	0x8b, 0x14, 0xaa, 0xcc, 0xe2, 0xa1, 0x48, 0xa1, 0x4a, 0x12, 0x29, 0x94, 0xaf, 0x04, 0x19, 0xc9,
-	0x03, 0x11, 0x47, 0x71, 0x10, 0x7b, 0x0e, 0xf1, 0x5a, 0xfd, 0xd4, 0xc6, 0x66, 0x07, 0x44, 0x9a,
-	0x3a, 0x12, 0xb9, 0x96, 0x23, 0xe1, 0x41, 0x3a, 0x7e, 0xe5, 0x78, 0xe9, 0xf0, 0xf8, 0x55, 0x47,
-	0xec, 0x60, 0xb6, 0x80, 0xd4, 0xb4, 0xb9, 0xb9, 0x6e, 0x74, 0xae, 0x32, 0x20, 0xee, 0x9c, 0xb8,
-	0x9f, 0x3b, 0xef, 0x72, 0x24, 0xee, 0x29, 0xec, 0x35, 0x4c, 0xdb, 0xcd, 0x7d, 0xc4, 0x94, 0x22,
-	0x8e, 0x28, 0xe2, 0xcb, 0xbd, 0x7f, 0x39, 0x12, 0x43, 0x1a, 0xd6, 0xb3, 0x6d, 0xf3, 0x52, 0x9b,
-	0x75, 0x36, 0x1b, 0xd4, 0xf3, 0xc9, 0xf9, 0xb0, 0x1e, 0x0f, 0x5f, 0xcd, 0x61, 0xd6, 0xb8, 0x21,
-	0x7f, 0x2b, 0xa4, 0x95, 0xfc, 0x1c, 0xd2, 0xbe, 0x12, 0x76, 0x0c, 0x91, 0xad, 0xb7, 0xfa, 0x9a,
-	0xe6, 0x9e, 0x0a, 0x67, 0xf0, 0x4b, 0x98, 0x0e, 0x52, 0x3f, 0x4e, 0x62, 0x73, 0x98, 0xe8, 0x82,
-	0xee, 0xc2, 0xa1, 0x98, 0xe8, 0x82, 0xbf, 0x83, 0xc4, 0x67, 0xdf, 0x11, 0xf0, 0x0c, 0x42, 0x2c,
-	0x20, 0x9b, 0x9c, 0x05, 0xfd, 0x30, 0xbe, 0xca, 0xb2, 0x55, 0x82, 0xfc, 0xfc, 0xd7, 0x18, 0x9e,
-	0xf4, 0xb7, 0xd2, 0x6c, 0xeb, 0x8d, 0x51, 0xec, 0x04, 0x62, 0x63, 0xa5, 0x6d, 0x0d, 0x49, 0x1d,
-	0x0a, 0x6f, 0xb1, 0x0c, 0x92, 0x4a, 0x19, 0x23, 0x57, 0x8a, 0x2a, 0x48, 0x45, 0x67, 0x32, 0x0e,
-	0xf1, 0x2d, 0x8a, 0x9a, 0x2c, 0xf8, 0x27, 0x8f, 0x47, 0xd8, 0x39, 0x44, 0x5b, 0xa9, 0x1b, 0x93,
-	0x85, 0x44, 0x71, 0x23, 0xff, 0x78, 0x8b, 0x3e, 0xe1, 0x10, 0xbe, 0x80, 0x10, 0xaf, 0x36, 0x1d,
-	0x45, 0xe6, 0xa5, 0xea, 0x8f, 0x82, 0x06, 0x3b, 0x82, 0xe0, 0x46, 0xdd, 0xf9, 0xd4, 0xf8, 0xcb,
-	0x4f, 0x21, 0xf1, 0xab, 0xf0, 0x78, 0x08, 0x7f, 0x09, 0x11, 0xed, 0xc0, 0x0e, 0x45, 0x06, 0xe1,
-	0x8d, 0xba, 0x33, 0xd4, 0x9c, 0x54, 0xd0, 0x3f, 0xff, 0x3d, 0x86, 0x88, 0x0a, 0x67, 0x19, 0xb6,
-	0xa1, 0xd1, 0x9b, 0x95, 0x0b, 0xc2, 0x7b, 0xe9, 0x6c, 0x44, 0x72, 0xbd, 0x91, 0x8d, 0x2b, 0x66,
-	0x86, 0x88, 0xb3, 0xd9, 0x53, 0x48, 0xf4, 0xc6, 0xaa, 0x95, 0x6a, 0x68, 0x11, 0x03, 0xbc, 0x13,
-	0xde, 0xc1, 0x4e, 0x20, 0xfa, 0x5e, 0xd6, 0xd2, 0xed, 0xdf, 0x18, 0x97, 0x81, 0x4c, 0x76, 0x0c,
-	0x61, 0x5e, 0xd7, 0x25, 0x2d, 0xdd, 0x01, 0xae, 0x11, 0x5a, 0x57, 0x09, 0x44, 0xd4, 0x38, 0xfe,
-	0x06, 0x62, 0xd7, 0xa5, 0xae, 0x01, 0xe3, 0xbe, 0x01, 0xec, 0xcc, 0x93, 0xfc, 0xeb, 0x30, 0x6c,
-	0xbb, 0x8f, 0x7e, 0x0b, 0x21, 0xae, 0xf8, 0x8e, 0x06, 0x9c, 0x42, 0x88, 0xca, 0x3e, 0xfc, 0xc1,
-	0x48, 0x08, 0xe0, 0xef, 0xb1, 0x81, 0xbb, 0xe3, 0xfb, 0x99, 0x4e, 0xfe, 0x3b, 0x53, 0x5c, 0xf9,
-	0x7d, 0x67, 0x4a, 0x23, 0xdb, 0x1d, 0xf0, 0xd8, 0xc8, 0x5e, 0x40, 0xec, 0x9e, 0x8e, 0xbd, 0x93,
-	0x5c, 0x42, 0xe2, 0x1f, 0x91, 0xfd, 0xd3, 0xe4, 0x31, 0x3d, 0xe6, 0x97, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x81, 0x32, 0xdb, 0x51, 0xd9, 0x05, 0x00, 0x00,
+	// 2351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x6b, 0x72, 0xdb, 0xc8,
+	0x11, 0xe6, 0x03, 0x7c, 0xa0, 0xf9, 0x10, 0x34, 0x76, 0xbc, 0xb0, 0xd6, 0xb1, 0x64, 0x78, 0xbd,
+	0xa5, 0xa8, 0x2a, 0x52, 0xfc, 0x48, 0xec, 0xec, 0xae, 0xb7, 0x96, 0x92, 0x68, 0x53, 0x65, 0x99,
+	0x52, 0x86, 0x94, 0x36, 0x71, 0x55, 0x8a, 0x05, 0x82, 0x23, 0x0a, 0x25, 0x10, 0x60, 0x00, 0x50,
+	0x96, 0xfe, 0xa7, 0x72, 0x88, 0x5c, 0x21, 0xf7, 0x49, 0x2a, 0x17, 0xc8, 0xbf, 0x5c, 0x20, 0xbf,
+	0x52, 0xdd, 0x33, 0x00, 0x41, 0x5b, 0xb2, 0x95, 0xec, 0x2f, 0xa0, 0xbb, 0xbf, 0xee, 0xe9, 0xe9,
+	0x99, 0x7e, 0x00, 0xa0, 0xdb, 0x43, 0x77, 0x73, 0x1a, 0x06, 0x71, 0xc0, 0x8a, 0xf6, 0xd0, 0x5d,
+	0xb9, 0x3f, 0x0e, 0x82, 0xb1, 0x27, 0xb6, 0x88, 0x35, 0x9c, 0x9d, 0x6c, 0x8d, 0x66, 0xa1, 0x1d,
+	0xbb, 0x81, 0x2f, 0x41, 0x2b, 0xab, 0x1f, 0xca, 0x63, 0x77, 0x22, 0xa2, 0xd8, 0x9e, 0x4c, 0x25,
+	0xc0, 0xfa, 0x4f, 0x1d, 0x9a, 0x3b, 0xc1, 0x64, 0x62, 0xfb, 0x23, 0x2e, 0xfe, 0x34, 0x13, 0x51,
+	0xcc, 0x56, 0x41, 0x3b, 0x1d, 0x8b, 0xd8, 0xcc, 0xaf, 0xe5, 0xd7, 0x6b, 0x4f, 0xf4, 0x4d, 0x5c,
+	0xb2, 0x33, 0x16, 0x71, 0x27, 0xc7, 0x49, 0xc0, 0xd6, 0xa1, 0x82, 0x4f, 0xdb, 0xf3, 0xcc, 0x02,
+	0x61, 0xea, 0x29, 0xc6, 0xf6, 0xbc, 0x4e, 0x8e, 0x27, 0x62, 0x66, 0x41, 0xe9, 0x74, 0x82, 0xb6,
+	0x8a, 0x84, 0x03, 0x89, 0x9b, 0x48, 0x63, 0x52, 0x44, 0xcb, 0x45, 0x22, 0x36, 0xb5, 0xec, 0x72,
+	0x91, 0x5a, 0x2e, 0x12, 0xb1, 0x34, 0x82, 0x88, 0xd2, 0x82, 0x91, 0x28, 0x31, 0x12, 0x29, 0x23,
+	0x23, 0xe1, 0x99, 0xe5, 0xac, 0x91, 0x91, 0xf0, 0xc8, 0xc8, 0x48, 0x28, 0x4f, 0x10, 0x51, 0x59,
+	0x30, 0x22, 0x21, 0x52, 0xc4, 0x1e, 0x41, 0xf9, 0x54, 0x5c, 0xb8, 0x51, 0x6c, 0x56, 0x09, 0x54,
+	0x93, 0x20, 0x62, 0x75, 0x72, 0x5c, 0x09, 0x69, 0xfb, 0x13, 0x89, 0xd3, 0xb3, 0xdb, 0x9f, 0x24,
+	0xc0, 0x44, 0xcc, 0x36, 0x41, 0x8f, 0x66, 0xc3, 0xc8, 0x09, 0xdd, 0xa1, 0x30, 0x81, 0xb0, 0x4d,
+	0xc2, 0xf6, 0x12, 0x6e, 0x27, 0xc7, 0xe7, 0x10, 0xf6, 0x0c, 0x6a, 0x33, 0x7f, 0xae, 0x51, 0x23,
+	0x0d, 0x83, 0x34, 0x8e, 0xe6, 0xfc, 0x4e, 0x8e, 0x67, 0x61, 0xe8, 0xcf, 0x74, 0x36, 0xf4, 0xdc,
+	0xe8, 0xd4, 0xac, 0x67, 0xfc, 0x39, 0x94, 0x3c, 0xf4, 0x47, 0x89, 0xd1, 0x1f, 0x5f, 0x8c, 0x83,
+	0xd8, 0xb5, 0x63, 0x61, 0x36, 0x32, 0xfe, 0x74, 0x13, 0x2e, 0xfa, 0x93, 0x42, 0x28, 0x68, 0xc2,
+	0xf3, 0x02, 0xb3, 0x99, 0x0d, 0x1a, 0x72, 0x28, 0x68, 0xf8, 0x82, 0x91, 0x9f, 0xba, 0xfe, 0xd8,
+	0x5c, 0xca, 0x44, 0xfe, 0xd0, 0xf5, 0xc7, 0x18, 0x79, 0x14, 0x20, 0x40, 0x38, 0xa7, 0x81, 0x69,
+	0x64, 0x00, 0x6d, 0xe7, 0x14, 0x4d, 0x90, 0x00, 0x01, 0xae, 0x7f, 0x12, 0x98, 0xcb, 0x19, 0xc0,
+	0x9e, 0x7f, 0x42, 0x00, 0x14, 0xe0, 0x06, 0x27, 0x81, 0xef, 0xc6, 0x41, 0x68, 0xb2, 0xcc, 0x06,
+	0xdf, 0x4a, 0x1e, 0x6e, 0x50, 0x89, 0xd9, 0x13, 0xa8, 0x45, 0x5e, 0xf0, 0xde, 0x0b, 0xc6, 0x03,
+	0xbc, 0x75, 0xb7, 0x08, 0xbd, 0x24, 0x43, 0x2e, 0xf9, 0xaf, 0xe9, 0xd6, 0x40, 0x94, 0x52, 0xec,
+	0x05, 0x34, 0x12, 0x9d, 0x50, 0xe0, 0x35, 0xbb, 0x4d, 0x5a, 0xcb, 0x59, 0x2d, 0x2e, 0xe4, 0x6d,
+	0xab, 0x47, 0x19, 0x1a, 0xc3, 0xf3, 0xde, 0x8e, 0x9d, 0x53, 0xf3, 0x67, 0x99, 0xf0, 0xfc, 0x88,
+	0x1c, 0x0c, 0x0f, 0x89, 0xd8, 0x63, 0x80, 0xe9, 0xfc, 0x44, 0xef, 0x64, 0x1c, 0x3a, 0xcc, 0x1e,
+	0x68, 0x06, 0xc4, 0x9e, 0x43, 0x7d, 0x9a, 0xbd, 0x06, 0x5f, 0x64, 0xfc, 0x39, 0x9c, 0x2d, 0xdc,
+	0x83, 0x05, 0x20, 0xfb, 0x1e, 0x96, 0x9c, 0xc0, 0x3f, 0x71, 0xc7, 0xb3, 0x50, 0x0c, 0xe2, 0x60,
+	0xea, 0x3a, 0xa6, 0x49, 0xba, 0xb7, 0x48, 0x77, 0x27, 0x91, 0xf5, 0x51, 0xd4, 0xc9, 0xf1, 0xa6,
+	0xb3, 0xc0, 0x59, 0xd4, 0x1f, 0x87, 0xc1, 0x6c, 0x6a, 0xde, 0xbd, 0x4a, 0xff, 0x35, 0x8a, 0x16,
+	0xf4, 0x89, 0xc3, 0xee, 0x41, 0xd1, 0x76, 0xce, 0xcc, 0x15, 0xd2, 0xa9, 0x92, 0x4e, 0xcb, 0x39,
+	0xeb, 0xe4, 0x38, 0xb2, 0xf1, 0x98, 0x7d, 0x14, 0x7f, 0x99, 0x39, 0xe6, 0xae, 0x4d, 0x72, 0x12,
+	0xb0, 0x6f, 0xa1, 0xe9, 0xb9, 0x51, 0x3c, 0x88, 0x9c, 0x53, 0x31, 0x9a, 0x79, 0x62, 0x64, 0xde,
+	0x23, 0x28, 0x23, 0xe8, 0xbe, 0x1b, 0xc5, 0xbd, 0x44, 0xd2, 0xc9, 0xf1, 0x86, 0x97, 0x65, 0xb0,
+	0x16, 0x18, 0x8e, 0xed, 0x3b, 0xc2, 0xcb, 0xa8, 0xff, 0x9c, 0xd4, 0x6f, 0x4b, 0xe7, 0x49, 0x98,
+	0x35, 0xb0, 0xe4, 0x2c, 0xb2, 0xf0, 0xf2, 0xd0, 0xfa, 0x14, 0xb9, 0xc8, 0xbc, 0x9f, 0x39, 0x2b,
+	0x5c, 0x9c, 0x62, 0x14, 0xe1, 0x59, 0x79, 0x29, 0xc5, 0xb6, 0x00, 0x08, 0x3e, 0xa0, 0x1b, 0xbc,
+	0x9a, 0x49, 0x29, 0x02, 0xa8, 0x6b, 0xac, 0xc7, 0x09, 0xc1, 0x5e, 0x03, 0x93, 0x9b, 0x94, 0xa7,
+	0x36, 0xc5, 0x5a, 0x1d, 0x99, 0x6b, 0xa4, 0x78, 0x67, 0xbe, 0xd1, 0xac, 0xb4, 0x93, 0xe3, 0xcb,
+	0xde, 0x87, 0x4c, 0xf6, 0x12, 0xaa, 0x13, 0x11, 0xdb, 0x23, 0x3b, 0xb6, 0xcd, 0xd1, 0x5a, 0x71,
+	0xbd, 0xf6, 0xe4, 0x81, 0x3a, 0xa5, 0x6c, 0x31, 0xdf, 0x7c, 0xab, 0x30, 0x6d, 0x3f, 0x0e, 0x2f,
+	0x79, 0xaa, 0xc2, 0x7e, 0x03, 0xd5, 0x91, 0xb0, 0x47, 0x9e, 0xeb, 0x0b, 0x53, 0xd0, 0xea, 0x2b,
+	0x9b, 0xb2, 0x57, 0x6c, 0x26, 0xbd, 0x62, 0xb3, 0x9f, 0xf4, 0x0a, 0x9e, 0x62, 0x57, 0xbe, 0x85,
+	0xc6, 0x82, 0x49, 0x66, 0x40, 0xf1, 0x4c, 0x5c, 0x52, 0xb3, 0xd0, 0x39, 0xbe, 0xb2, 0xdb, 0x50,
+	0x3a, 0xb7, 0xbd, 0x99, 0xa0, 0xe6, 0xa0, 0x73, 0x49, 0x7c, 0x53, 0x78, 0x91, 0xdf, 0x6e, 0x42,
+	0x3d, 0x94, 0x7e, 0x0d, 0xd0, 0x80, 0xb5, 0x0d, 0x25, 0xaa, 0x26, 0xcc, 0x84, 0xca, 0xb9, 0x08,
+	0x23, 0x37, 0xf0, 0xc9, 0x50, 0x83, 0x27, 0x24, 0x5b, 0x85, 0x9a, 0xe3, 0xb9, 0xc2, 0x8f, 0x07,
+	0xbe, 0x3d, 0x49, 0x4c, 0x82, 0x64, 0x75, 0xed, 0x89, 0xb0, 0xfe, 0x08, 0x7a, 0x5a, 0xbd, 0xd8,
+	0x33, 0xa8, 0x3b, 0xc1, 0x64, 0x1a, 0x8a, 0x28, 0xa2, 0xb8, 0xe6, 0xd7, 0x8a, 0xeb, 0x4d, 0x55,
+	0x41, 0x77, 0xe6, 0x02, 0xbe, 0x80, 0x62, 0x2b, 0x50, 0x75, 0x4e, 0x85, 0x73, 0x16, 0xcd, 0x26,
+	0xb4, 0x40, 0x95, 0xa7, 0xb4, 0x55, 0x06, 0x0d, 0xab, 0x99, 0xb5, 0x01, 0x1a, 0x16, 0x2d, 0x66,
+	0x41, 0x99, 0xf6, 0x23, 0x6d, 0x27, 0x49, 0x7f, 0x8c, 0x2c, 0xae, 0x24, 0x96, 0x05, 0x1a, 0x9d,
+	0xf5, 0x0a, 0x54, 0x23, 0xe1, 0xc4, 0xa9, 0x27, 0x3a, 0x4f, 0x69, 0xeb, 0x25, 0x54, 0x54, 0xfd,
+	0x62, 0x77, 0xa0, 0x1c, 0xdb, 0x43, 0x4f, 0x24, 0x20, 0x45, 0x91, 0x5b, 0xf2, 0x30, 0x23, 0xb3,
+	0x20, 0xd5, 0x13, 0xda, 0xb2, 0x00, 0xe6, 0x05, 0x0d, 0x23, 0xee, 0x04, 0x33, 0x3f, 0x56, 0xc1,
+	0x93, 0x84, 0xd5, 0x84, 0x7a, 0xb6, 0x7c, 0x59, 0xc7, 0x50, 0xa2, 0xe2, 0x84, 0x70, 0x5a, 0x42,
+	0x1d, 0x9a, 0x24, 0x18, 0x03, 0xed, 0x4c, 0x5c, 0x26, 0x4b, 0xd1, 0x3b, 0x7b, 0x08, 0x8d, 0xc8,
+	0xf5, 0x1d, 0x31, 0x48, 0x4e, 0x07, 0xfb, 0xb8, 0xc6, 0xeb, 0xc4, 0x3c, 0x96, 0x3c, 0xeb, 0x1f,
+	0x79, 0xd0, 0xd3, 0x86, 0x46, 0xc6, 0xa9, 0xf4, 0x24, 0xc6, 0xa9, 0xb4, 0xac, 0x43, 0x29, 0x8a,
+	0xed, 0x30, 0xa6, 0xf8, 0x36, 0x55, 0x4a, 0xf7, 0x90, 0x73, 0x18, 0x44, 0x2e, 0x86, 0x84, 0x4b,
+	0x00, 0x46, 0x23, 0x38, 0x39, 0x89, 0xd4, 0xcc, 0xa0, 0x71, 0x45, 0xa1, 0x5d, 0x59, 0x92, 0x34,
+	0x69, 0x97, 0x08, 0xf6, 0x1c, 0xf4, 0x53, 0x61, 0x87, 0xf1, 0x50, 0xd8, 0xc9, 0x7c, 0x70, 0xf7,
+	0xa3, 0x7b, 0xbc, 0xab, 0x66, 0x22, 0x3e, 0xc7, 0xb2, 0x87, 0x50, 0x3e, 0x71, 0xbd, 0x58, 0x84,
+	0x6a, 0x64, 0x90, 0xbd, 0xfe, 0x15, 0xb1, 0xb8, 0x12, 0x59, 0x7f, 0xcb, 0x43, 0x59, 0xb2, 0xb0,
+	0x75, 0x3a, 0x81, 0x3f, 0x22, 0x57, 0xd5, 0x64, 0xd4, 0x4c, 0xaa, 0xa2, 0xe4, 0x62, 0x9e, 0xa7,
+	0x10, 0xf6, 0x10, 0x8a, 0xf3, 0xf9, 0x68, 0x29, 0x63, 0x7c, 0x5f, 0xce, 0x08, 0x28, 0x25, 0x90,
+	0x7f, 0xa9, 0x86, 0xa3, 0x2b, 0x41, 0xfe, 0x25, 0x5b, 0x85, 0xa2, 0x1f, 0x24, 0xe3, 0x51, 0xd6,
+	0x4d, 0x04, 0xf8, 0x41, 0xbc, 0x5d, 0x06, 0x4d, 0x5c, 0x4c, 0x43, 0xeb, 0x29, 0xc0, 0x5c, 0x9b,
+	0x3d, 0x82, 0x8a, 0xdc, 0x45, 0x72, 0x53, 0x17, 0x76, 0x98, 0xc8, 0xac, 0x77, 0xa0, 0xa7, 0x3b,
+	0xc0, 0x2b, 0x30, 0xb5, 0xe3, 0x53, 0x75, 0x74, 0xf4, 0xce, 0xbe, 0x80, 0x42, 0x30, 0x55, 0xc7,
+	0x56, 0x21, 0x13, 0x07, 0x53, 0x5e, 0x08, 0xa6, 0x6c, 0x2d, 0x49, 0xf3, 0xec, 0x6c, 0x27, 0x13,
+	0x41, 0x0a, 0xac, 0x7f, 0xe6, 0x71, 0xb6, 0x5c, 0x68, 0x11, 0x57, 0xdf, 0x8e, 0xf4, 0x6c, 0x0b,
+	0xd9, 0xb3, 0xed, 0x00, 0x3b, 0x77, 0x23, 0x77, 0xe8, 0x7a, 0x6e, 0x7c, 0x39, 0xc0, 0xc1, 0x35,
+	0x98, 0x25, 0x93, 0xe4, 0x27, 0x0e, 0x79, 0x79, 0xae, 0xd4, 0x97, 0x3a, 0xec, 0x11, 0x34, 0x27,
+	0xf6, 0xc5, 0x60, 0x24, 0x3c, 0xf7, 0x5c, 0x84, 0xae, 0x88, 0x28, 0x9a, 0x0d, 0xde, 0x98, 0xd8,
+	0x17, 0xbb, 0x29, 0x93, 0x6d, 0xc0, 0x32, 0xd6, 0xb9, 0x81, 0x27, 0xe2, 0x58, 0x84, 0xaa, 0x83,
+	0x96, 0xc8, 0xa5, 0x25, 0x14, 0xec, 0x13, 0x9f, 0x0a, 0xbb, 0xb5, 0x03, 0xc5, 0x96, 0x73, 0xf6,
+	0x3f, 0xed, 0xc7, 0x80, 0xa2, 0x3b, 0x8a, 0xcc, 0xe2, 0x5a, 0x71, 0x5d, 0xe3, 0xf8, 0x6a, 0xed,
+	0x82, 0x86, 0x1d, 0xf0, 0x27, 0x5a, 0xf9, 0x6b, 0x36, 0xcc, 0xfd, 0x44, 0xf5, 0x0a, 0x83, 0x0f,
+	0xa0, 0x8e, 0x61, 0x98, 0x88, 0x28, 0xb2, 0xc7, 0x22, 0x22, 0xbb, 0x1a, 0xaf, 0x4d, 0xec, 0x8b,
+	0xb7, 0x8a, 0xc5, 0x9e, 0x40, 0x05, 0x21, 0xf6, 0x58, 0x7c, 0x3e, 0xd0, 0xe5, 0x89, 0x7d, 0xd1,
+	0x1a, 0x0b, 0xf6, 0x25, 0xe8, 0xa8, 0x33, 0xbc, 0x8c, 0x55, 0x60, 0x35, 0x5e, 0x9d, 0xd8, 0x17,
+	0xdb, 0x48, 0x5b, 0x4f, 0xa1, 0x96, 0x19, 0x5d, 0xaf, 0x71, 0xac, 0x09, 0x05, 0x77, 0x44, 0xee,
+	0x34, 0x78, 0xc1, 0x1d, 0x59, 0x03, 0x80, 0xf9, 0x74, 0x84, 0xcd, 0x61, 0x6a, 0x63, 0xe4, 0x7d,
+	0xa5, 0x95, 0x90, 0x8b, 0xd9, 0x5f, 0xb8, 0x79, 0xf6, 0x5b, 0x2f, 0xa0, 0x9e, 0x9d, 0xa4, 0x3e,
+	0xb1, 0xc4, 0x87, 0xae, 0xfd, 0xa5, 0x00, 0x15, 0x35, 0x59, 0x5f, 0xb3, 0x99, 0xfb, 0xa0, 0x51,
+	0x53, 0x2e, 0x7c, 0xd4, 0x1f, 0x88, 0x8f, 0xe1, 0x7a, 0x6f, 0xbb, 0xf1, 0xc0, 0x76, 0xce, 0x22,
+	0x0a, 0x72, 0x83, 0x57, 0x91, 0xd1, 0x72, 0xce, 0x22, 0xf6, 0x1d, 0xd4, 0x49, 0x98, 0xdc, 0x76,
+	0xed, 0x73, 0x9b, 0xaa, 0x21, 0x3c, 0xb9, 0xe7, 0xbf, 0x05, 0x50, 0x77, 0x7c, 0x90, 0x96, 0xc3,
+	0x4f, 0xb5, 0x75, 0x5d, 0xa1, 0x5b, 0x31, 0xdb, 0x82, 0xd2, 0x48, 0x78, 0xf6, 0xa5, 0x2a, 0x87,
+	0x9f, 0x58, 0x51, 0xe2, 0xac, 0x47, 0xd0, 0x58, 0x18, 0xc9, 0xae, 0x8e, 0x86, 0xf5, 0x35, 0xc0,
+	0x7c, 0x78, 0xba, 0x3e, 0xce, 0xd6, 0x03, 0xd0, 0xd3, 0x89, 0xe9, 0x1a, 0x53, 0xbf, 0x80, 0xe5,
+	0x8f, 0x66, 0xa3, 0x6b, 0xa0, 0xcf, 0x61, 0xe9, 0x83, 0x81, 0xef, 0xb3, 0x37, 0x4f, 0xa3, 0xe3,
+	0xfd, 0x7b, 0x11, 0x96, 0xd2, 0x09, 0x2a, 0x9a, 0x06, 0x7e, 0x24, 0xb0, 0x23, 0x45, 0xb1, 0x1d,
+	0xcf, 0x22, 0xd5, 0x5e, 0x15, 0x85, 0x9b, 0x51, 0xa9, 0xa4, 0x32, 0x34, 0x21, 0x33, 0x43, 0x42,
+	0xf1, 0xba, 0x21, 0x81, 0x3d, 0x80, 0xd2, 0xd4, 0x76, 0x43, 0xcc, 0x98, 0x79, 0x75, 0x7e, 0x73,
+	0x8e, 0x3c, 0x2e, 0x25, 0xec, 0xfb, 0xcc, 0x88, 0x57, 0x22, 0x94, 0xb5, 0x38, 0xe2, 0x49, 0x07,
+	0x6f, 0x34, 0xe3, 0x95, 0x6f, 0x3e, 0xe3, 0xcd, 0x43, 0x55, 0x59, 0x6c, 0xe1, 0x55, 0xe1, 0x9f,
+	0x0b, 0x2f, 0x98, 0x0a, 0xf5, 0x7d, 0xac, 0x3e, 0xc3, 0xe4, 0xa6, 0x79, 0x2a, 0x65, 0x5f, 0x43,
+	0xe9, 0x24, 0xc4, 0x69, 0x4d, 0xa7, 0xae, 0x61, 0xa8, 0x66, 0x1f, 0x0a, 0x7b, 0xf2, 0x0a, 0xf9,
+	0x5c, 0x8a, 0xd9, 0x2f, 0x01, 0x84, 0x3f, 0x1a, 0x84, 0xc2, 0x8e, 0x02, 0x9f, 0xbe, 0x8f, 0x9b,
+	0xaa, 0xa9, 0xb6, 0x71, 0x77, 0xc8, 0xe5, 0xba, 0x48, 0x5e, 0x7f, 0xd2, 0xe8, 0x69, 0xfd, 0x2b,
+	0x0f, 0x15, 0xe5, 0xe9, 0xcd, 0xae, 0x02, 0x7b, 0x89, 0x9f, 0x61, 0x94, 0xe8, 0x62, 0x84, 0xe9,
+	0x54, 0xfc, 0x6c, 0x04, 0x6b, 0x29, 0xbe, 0x15, 0xb3, 0x7b, 0xa0, 0x27, 0x64, 0xa8, 0x66, 0x96,
+	0x39, 0x83, 0x7d, 0x85, 0x89, 0x70, 0xe9, 0x05, 0xf6, 0x48, 0x9d, 0x6c, 0xf6, 0x8a, 0x24, 0xa2,
+	0xcc, 0x2c, 0x54, 0x5e, 0x98, 0x85, 0x56, 0xf0, 0x60, 0x29, 0x73, 0x2f, 0xe9, 0x8c, 0x1a, 0x3c,
+	0xa5, 0xad, 0x4d, 0xd0, 0x3a, 0x63, 0x39, 0x2f, 0x5d, 0x31, 0xe4, 0xa9, 0x90, 0x15, 0xd2, 0x90,
+	0x59, 0xab, 0x50, 0x51, 0x3f, 0x6e, 0xae, 0x56, 0xb1, 0x1e, 0x43, 0x89, 0xfe, 0xd8, 0xdc, 0x7c,
+	0x6c, 0xb4, 0xfe, 0x5d, 0x80, 0x12, 0x6d, 0x85, 0x99, 0x98, 0x3b, 0xa1, 0xeb, 0x8f, 0xa5, 0x52,
+	0x27, 0xc7, 0x15, 0x8d, 0x92, 0xa1, 0xeb, 0xdb, 0xa1, 0x74, 0xa6, 0x8e, 0x12, 0x49, 0xb3, 0x15,
+	0xa8, 0xb8, 0x7e, 0x2c, 0xc6, 0x22, 0xa4, 0x98, 0x17, 0xf1, 0x03, 0x5f, 0x31, 0xd8, 0x1d, 0x28,
+	0x9d, 0x78, 0x81, 0x2d, 0x0b, 0x63, 0x1e, 0x3f, 0xb3, 0x89, 0x64, 0xb7, 0x41, 0x1b, 0x06, 0x81,
+	0x47, 0x35, 0xaf, 0x8a, 0x5f, 0x94, 0x48, 0xb1, 0x6f, 0x40, 0x4f, 0xff, 0x77, 0x7d, 0x3e, 0x03,
+	0xe8, 0x43, 0x2d, 0x21, 0xd8, 0x73, 0xa8, 0x26, 0xff, 0xd2, 0xd4, 0x3f, 0xa3, 0xeb, 0x6b, 0x62,
+	0x27, 0xc7, 0x53, 0x30, 0xfb, 0x0a, 0x34, 0x6f, 0xfe, 0x0f, 0xa9, 0x39, 0x3f, 0x57, 0x35, 0xd4,
+	0x91, 0x94, 0x3d, 0x80, 0xe2, 0xc4, 0x9e, 0xaa, 0x1f, 0x48, 0x8d, 0x39, 0xe8, 0xad, 0x8d, 0x7e,
+	0xa0, 0x8c, 0x3e, 0x98, 0x67, 0x9e, 0xa7, 0x12, 0x43, 0x7d, 0x30, 0xcf, 0xe8, 0x07, 0x1b, 0x09,
+	0xb6, 0x2b, 0xea, 0xb6, 0x5b, 0x5b, 0xa0, 0xa7, 0x2b, 0xdc, 0xe8, 0x0b, 0xe5, 0xcf, 0x79, 0xa8,
+	0x26, 0xcb, 0xb1, 0xc7, 0x1f, 0x28, 0xdc, 0x5d, 0xf0, 0x46, 0xbe, 0x44, 0xb2, 0xb6, 0x28, 0xe0,
+	0x4a, 0x1b, 0x6a, 0x19, 0xf6, 0x15, 0x89, 0xb8, 0x96, 0x4d, 0xc4, 0xab, 0x86, 0x43, 0x4a, 0xca,
+	0xef, 0xa0, 0x2c, 0x2b, 0xde, 0xff, 0x63, 0xc1, 0x7a, 0x09, 0x5a, 0x27, 0xba, 0xf6, 0x5e, 0xae,
+	0xe2, 0x2c, 0xeb, 0x86, 0x4a, 0x7d, 0xa1, 0xbc, 0x92, 0xc0, 0xfa, 0x01, 0xef, 0xf5, 0xf5, 0xfa,
+	0x69, 0x7d, 0x2e, 0x5c, 0x57, 0x9f, 0x29, 0xd5, 0x46, 0xc2, 0xbb, 0x71, 0xaa, 0x51, 0x26, 0x5d,
+	0xaf, 0x70, 0x55, 0x26, 0xfd, 0x0a, 0xca, 0xf2, 0xff, 0xe3, 0x8d, 0x17, 0x79, 0x0a, 0x15, 0xf5,
+	0x27, 0xf2, 0xe6, 0xcb, 0x6c, 0xfc, 0x00, 0xb5, 0xcc, 0xe7, 0x31, 0xab, 0x82, 0xf6, 0xfa, 0xdd,
+	0xde, 0xa1, 0x91, 0xc3, 0xb7, 0x77, 0xbd, 0xfe, 0xae, 0x91, 0x67, 0x00, 0xe5, 0x5e, 0xb7, 0x75,
+	0x78, 0xf8, 0x07, 0xa3, 0xc0, 0x2a, 0x50, 0xdc, 0x7f, 0xf7, 0xcc, 0x28, 0xa2, 0xb8, 0x7b, 0xd0,
+	0x6d, 0x1b, 0x95, 0x8d, 0x2e, 0x14, 0x0e, 0xa6, 0xac, 0x0c, 0x85, 0xf6, 0xef, 0x8c, 0x1c, 0x3e,
+	0xbb, 0x6d, 0x23, 0x8f, 0xcf, 0xfd, 0xbe, 0x51, 0xa0, 0x67, 0xdb, 0x28, 0xe2, 0xf3, 0x75, 0xdf,
+	0xd0, 0xe8, 0xd9, 0x36, 0x4a, 0x68, 0xf4, 0x90, 0xb7, 0x5f, 0xed, 0xfd, 0xde, 0x28, 0xb3, 0x3a,
+	0x54, 0x77, 0x0e, 0xba, 0xfd, 0xd6, 0x5e, 0xb7, 0x67, 0x54, 0x36, 0x7e, 0x0d, 0x8d, 0x85, 0xcf,
+	0x43, 0x84, 0xee, 0xb7, 0xfa, 0xed, 0x5e, 0xdf, 0xc8, 0x21, 0xb4, 0xdd, 0xe2, 0xfb, 0x7b, 0x48,
+	0x91, 0x67, 0x07, 0xaf, 0x5e, 0xf5, 0xda, 0x7d, 0xa3, 0xb0, 0xb1, 0x05, 0xb5, 0x4c, 0xa3, 0x41,
+	0xff, 0x76, 0x5b, 0xfd, 0x96, 0x91, 0x63, 0x0d, 0xd0, 0x3b, 0xed, 0x16, 0xef, 0x6f, 0xb7, 0x5b,
+	0xa8, 0x53, 0x81, 0x62, 0xbb, 0xbb, 0x6b, 0x14, 0x36, 0x8e, 0x41, 0x4f, 0x9b, 0x0d, 0xab, 0x41,
+	0xe5, 0xa8, 0xfb, 0xa6, 0x7b, 0xf0, 0x63, 0xd7, 0xc8, 0x31, 0x03, 0xea, 0x47, 0xdd, 0xde, 0xd1,
+	0x76, 0x6f, 0x87, 0xef, 0x6d, 0xb7, 0x31, 0x04, 0xb7, 0x60, 0xa9, 0xd7, 0xe6, 0xc7, 0x6d, 0x3e,
+	0xe8, 0x75, 0x8e, 0xfa, 0xbb, 0x08, 0x2b, 0xe0, 0xea, 0x6f, 0xf6, 0x76, 0xde, 0xb4, 0x77, 0x8d,
+	0x22, 0xd3, 0xa1, 0xd4, 0xe6, 0xfc, 0x80, 0x1b, 0xda, 0xc6, 0x1d, 0xd0, 0x30, 0x57, 0x59, 0x13,
+	0xa0, 0x7b, 0xb4, 0xbf, 0x3f, 0x38, 0x6e, 0xed, 0x1f, 0xb5, 0x8d, 0xdc, 0xb0, 0x4c, 0xc5, 0xe3,
+	0xe9, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x5e, 0x37, 0x6b, 0xc9, 0x17, 0x00, 0x00,
 }
@@ -0,0 +1,133 @@
+package abi
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ValueOf converts a Go value into a Value. nil becomes an explicit null,
+// slices (other than []byte) become lists and maps keyed by string become maps.
+// Unsigned integers above math.MaxInt64 are rejected rather than wrapped.
+func ValueOf(v interface{}) (*Value, error) {
+	switch x := v.(type) {
+	case nil:
+		return &Value{Value: &Value_Null{Null: Null_NULL_VALUE}}, nil
+	case *Value:
+		return x, nil
+	case string:
+		return &Value{Value: &Value_String_{String_: x}}, nil
+	case []byte:
+		return &Value{Value: &Value_Binary{Binary: x}}, nil
+	case bool:
+		return &Value{Value: &Value_Bool{Bool: x}}, nil
+	case time.Time:
+		return &Value{Value: &Value_Timestamp{Timestamp: timestamppb.New(x)}}, nil
+	case time.Duration:
+		return &Value{Value: &Value_Duration{Duration: durationpb.New(x)}}, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Value{Value: &Value_Integer{Integer: rv.Int()}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return nil, fmt.Errorf("abi: %d overflows int64", rv.Uint())
+		}
+		return &Value{Value: &Value_Integer{Integer: int64(rv.Uint())}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Value{Value: &Value_Float{Float: rv.Float()}}, nil
+	case reflect.String:
+		return &Value{Value: &Value_String_{String_: rv.String()}}, nil
+	case reflect.Bool:
+		return &Value{Value: &Value_Bool{Bool: rv.Bool()}}, nil
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return ValueOf(nil)
+		}
+		return ValueOf(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return ValueOf(nil)
+		}
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			return &Value{Value: &Value_Binary{Binary: rv.Bytes()}}, nil
+		}
+		list := &ValueList{Values: make([]*Value, rv.Len())}
+		for i := 0; i < rv.Len(); i++ {
+			elem, err := ValueOf(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			list.Values[i] = elem
+		}
+		return &Value{Value: &Value_List{List: list}}, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("abi: unsupported map key type %s", rv.Type().Key())
+		}
+		if rv.IsNil() {
+			return ValueOf(nil)
+		}
+		m := &ValueMap{Values: make(map[string]*Value, rv.Len())}
+		iter := rv.MapRange()
+		for iter.Next() {
+			elem, err := ValueOf(iter.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			m.Values[iter.Key().String()] = elem
+		}
+		return &Value{Value: &Value_Map{Map: m}}, nil
+	}
+	return nil, fmt.Errorf("abi: unsupported value type %T", v)
+}
+
+// Interface converts a Value back into its Go representation: string, []byte,
+// int64, float64, bool, time.Time, time.Duration, []interface{},
+// map[string]interface{}, or nil for null and unset values.
+func (m *Value) Interface() interface{} {
+	switch x := m.GetValue().(type) {
+	case *Value_String_:
+		return x.String_
+	case *Value_Binary:
+		return x.Binary
+	case *Value_Integer:
+		return x.Integer
+	case *Value_Float:
+		return x.Float
+	case *Value_Bool:
+		return x.Bool
+	case *Value_Timestamp:
+		return x.Timestamp.AsTime()
+	case *Value_Duration:
+		return x.Duration.AsDuration()
+	case *Value_List:
+		list := make([]interface{}, len(x.List.GetValues()))
+		for i, v := range x.List.GetValues() {
+			list[i] = v.Interface()
+		}
+		return list
+	case *Value_Map:
+		m := make(map[string]interface{}, len(x.Map.GetValues()))
+		for k, v := range x.Map.GetValues() {
+			m[k] = v.Interface()
+		}
+		return m
+	}
+	return nil
+}
+
+// IsNull reports whether the value is an explicit null or unset.
+func (m *Value) IsNull() bool {
+	switch m.GetValue().(type) {
+	case nil, *Value_Null:
+		return true
+	}
+	return false
+}
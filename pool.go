@@ -0,0 +1,60 @@
+package kvgo
+
+import (
+	"bytes"
+	"sync"
+)
+
+// buffers larger than this are left to the GC instead of pinning memory in the pool
+const MAX_POOLED_BUFFER = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	b := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+	return b
+}
+
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > MAX_POOLED_BUFFER {
+		return
+	}
+	bufferPool.Put(b)
+}
+
+var bytesPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, COMPRESSION_LIMIT)
+		return &b
+	},
+}
+
+// getBytes returns a pooled slice of length n.
+func getBytes(n int) *[]byte {
+	b := bytesPool.Get().(*[]byte)
+	resizeBytes(b, n)
+	return b
+}
+
+// resizeBytes sets the length of b to n, keeping its content when it grows.
+func resizeBytes(b *[]byte, n int) []byte {
+	if cap(*b) < n {
+		grown := make([]byte, n)
+		copy(grown, *b)
+		*b = grown
+	}
+	*b = (*b)[:n]
+	return *b
+}
+
+func putBytes(b *[]byte) {
+	if cap(*b) > MAX_POOLED_BUFFER {
+		return
+	}
+	bytesPool.Put(b)
+}